@@ -0,0 +1,717 @@
+package gotypedjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"time"
+)
+
+// TypeMismatchError is returned by the accessor methods when the stored Type is not the requested type
+type TypeMismatchError struct {
+	// Stored is the Type of the TypedJson
+	Stored JSONTYPE
+	// Requested is the type the accessor reads
+	Requested JSONTYPE
+}
+
+func (err *TypeMismatchError) Error() string {
+	return fmt.Sprintf("failed to access type '%s' as type '%s'", err.Stored, err.Requested)
+}
+
+// access returns the Value when the stored Type is the requested type. A nil Value is returned as the zero value.
+func access[T any](typedJson *TypedJson, requested JSONTYPE) (T, error) {
+	var zero T
+	if typedJson.Type != requested {
+		return zero, &TypeMismatchError{Stored: typedJson.Type, Requested: requested}
+	}
+
+	if typedJson.Value == nil {
+		return zero, nil
+	}
+
+	value, ok := typedJson.Value.(T)
+	if !ok {
+		return zero, fmt.Errorf("failed to cast '%v' to a %s", typedJson.Value, reflect.TypeFor[T]())
+	}
+
+	return value, nil
+}
+
+// must panics if the accessor returned an error
+func must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// Int returns the Value of an INT type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Int() (int, error) {
+	return access[int](typedJson, INT)
+}
+
+// MustInt returns the Value of an INT type and panics for any other type
+func (typedJson *TypedJson) MustInt() int {
+	return must(typedJson.Int())
+}
+
+// Int8 returns the Value of an INT8 type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Int8() (int8, error) {
+	return access[int8](typedJson, INT8)
+}
+
+// MustInt8 returns the Value of an INT8 type and panics for any other type
+func (typedJson *TypedJson) MustInt8() int8 {
+	return must(typedJson.Int8())
+}
+
+// Int16 returns the Value of an INT16 type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Int16() (int16, error) {
+	return access[int16](typedJson, INT16)
+}
+
+// MustInt16 returns the Value of an INT16 type and panics for any other type
+func (typedJson *TypedJson) MustInt16() int16 {
+	return must(typedJson.Int16())
+}
+
+// Int32 returns the Value of an INT32 type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Int32() (int32, error) {
+	return access[int32](typedJson, INT32)
+}
+
+// MustInt32 returns the Value of an INT32 type and panics for any other type
+func (typedJson *TypedJson) MustInt32() int32 {
+	return must(typedJson.Int32())
+}
+
+// Int64 returns the Value of an INT64 type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Int64() (int64, error) {
+	return access[int64](typedJson, INT64)
+}
+
+// MustInt64 returns the Value of an INT64 type and panics for any other type
+func (typedJson *TypedJson) MustInt64() int64 {
+	return must(typedJson.Int64())
+}
+
+// Uint returns the Value of an UINT type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Uint() (uint, error) {
+	return access[uint](typedJson, UINT)
+}
+
+// MustUint returns the Value of an UINT type and panics for any other type
+func (typedJson *TypedJson) MustUint() uint {
+	return must(typedJson.Uint())
+}
+
+// Uint8 returns the Value of an UINT8 type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Uint8() (uint8, error) {
+	return access[uint8](typedJson, UINT8)
+}
+
+// MustUint8 returns the Value of an UINT8 type and panics for any other type
+func (typedJson *TypedJson) MustUint8() uint8 {
+	return must(typedJson.Uint8())
+}
+
+// Uint16 returns the Value of an UINT16 type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Uint16() (uint16, error) {
+	return access[uint16](typedJson, UINT16)
+}
+
+// MustUint16 returns the Value of an UINT16 type and panics for any other type
+func (typedJson *TypedJson) MustUint16() uint16 {
+	return must(typedJson.Uint16())
+}
+
+// Uint32 returns the Value of an UINT32 type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Uint32() (uint32, error) {
+	return access[uint32](typedJson, UINT32)
+}
+
+// MustUint32 returns the Value of an UINT32 type and panics for any other type
+func (typedJson *TypedJson) MustUint32() uint32 {
+	return must(typedJson.Uint32())
+}
+
+// Uint64 returns the Value of an UINT64 type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Uint64() (uint64, error) {
+	return access[uint64](typedJson, UINT64)
+}
+
+// MustUint64 returns the Value of an UINT64 type and panics for any other type
+func (typedJson *TypedJson) MustUint64() uint64 {
+	return must(typedJson.Uint64())
+}
+
+// Float32 returns the Value of a FLOAT32 type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Float32() (float32, error) {
+	return access[float32](typedJson, FLOAT32)
+}
+
+// MustFloat32 returns the Value of a FLOAT32 type and panics for any other type
+func (typedJson *TypedJson) MustFloat32() float32 {
+	return must(typedJson.Float32())
+}
+
+// Float64 returns the Value of a FLOAT64 type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Float64() (float64, error) {
+	return access[float64](typedJson, FLOAT64)
+}
+
+// MustFloat64 returns the Value of a FLOAT64 type and panics for any other type
+func (typedJson *TypedJson) MustFloat64() float64 {
+	return must(typedJson.Float64())
+}
+
+// StringValue returns the Value of a STRING type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) StringValue() (string, error) {
+	return access[string](typedJson, STRING)
+}
+
+// MustStringValue returns the Value of a STRING type and panics for any other type
+func (typedJson *TypedJson) MustStringValue() string {
+	return must(typedJson.StringValue())
+}
+
+// Bool returns the Value of a BOOL type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Bool() (bool, error) {
+	return access[bool](typedJson, BOOL)
+}
+
+// MustBool returns the Value of a BOOL type and panics for any other type
+func (typedJson *TypedJson) MustBool() bool {
+	return must(typedJson.Bool())
+}
+
+// Time returns the Value of a DATETIME type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Time() (time.Time, error) {
+	return access[time.Time](typedJson, DATETIME)
+}
+
+// MustTime returns the Value of a DATETIME type and panics for any other type
+func (typedJson *TypedJson) MustTime() time.Time {
+	return must(typedJson.Time())
+}
+
+// Duration returns the Value of a TIME_DURATION type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Duration() (time.Duration, error) {
+	return access[time.Duration](typedJson, TIME_DURATION)
+}
+
+// MustDuration returns the Value of a TIME_DURATION type and panics for any other type
+func (typedJson *TypedJson) MustDuration() time.Duration {
+	return must(typedJson.Duration())
+}
+
+// Complex64 returns the Value of a COMPLEX64 type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Complex64() (complex64, error) {
+	return access[complex64](typedJson, COMPLEX64)
+}
+
+// MustComplex64 returns the Value of a COMPLEX64 type and panics for any other type
+func (typedJson *TypedJson) MustComplex64() complex64 {
+	return must(typedJson.Complex64())
+}
+
+// Complex128 returns the Value of a COMPLEX128 type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Complex128() (complex128, error) {
+	return access[complex128](typedJson, COMPLEX128)
+}
+
+// MustComplex128 returns the Value of a COMPLEX128 type and panics for any other type
+func (typedJson *TypedJson) MustComplex128() complex128 {
+	return must(typedJson.Complex128())
+}
+
+// BigInt returns the Value of a BIG_INT type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) BigInt() (*big.Int, error) {
+	return access[*big.Int](typedJson, BIG_INT)
+}
+
+// MustBigInt returns the Value of a BIG_INT type and panics for any other type
+func (typedJson *TypedJson) MustBigInt() *big.Int {
+	return must(typedJson.BigInt())
+}
+
+// BigFloat returns the Value of a BIG_FLOAT type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) BigFloat() (*big.Float, error) {
+	return access[*big.Float](typedJson, BIG_FLOAT)
+}
+
+// MustBigFloat returns the Value of a BIG_FLOAT type and panics for any other type
+func (typedJson *TypedJson) MustBigFloat() *big.Float {
+	return must(typedJson.BigFloat())
+}
+
+// BigRat returns the Value of a BIG_RAT type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) BigRat() (*big.Rat, error) {
+	return access[*big.Rat](typedJson, BIG_RAT)
+}
+
+// MustBigRat returns the Value of a BIG_RAT type and panics for any other type
+func (typedJson *TypedJson) MustBigRat() *big.Rat {
+	return must(typedJson.BigRat())
+}
+
+// Int128 returns the Value of an INT128 type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Int128() (Int128, error) {
+	return access[Int128](typedJson, INT128)
+}
+
+// MustInt128 returns the Value of an INT128 type and panics for any other type
+func (typedJson *TypedJson) MustInt128() Int128 {
+	return must(typedJson.Int128())
+}
+
+// Uint128 returns the Value of an UINT128 type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Uint128() (Uint128, error) {
+	return access[Uint128](typedJson, UINT128)
+}
+
+// MustUint128 returns the Value of an UINT128 type and panics for any other type
+func (typedJson *TypedJson) MustUint128() Uint128 {
+	return must(typedJson.Uint128())
+}
+
+// Date returns the Value of a DATE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Date() (Date, error) {
+	return access[Date](typedJson, DATE)
+}
+
+// MustDate returns the Value of a DATE type and panics for any other type
+func (typedJson *TypedJson) MustDate() Date {
+	return must(typedJson.Date())
+}
+
+// TimeOfDay returns the Value of a TIME_OF_DAY type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) TimeOfDay() (TimeOfDay, error) {
+	return access[TimeOfDay](typedJson, TIME_OF_DAY)
+}
+
+// MustTimeOfDay returns the Value of a TIME_OF_DAY type and panics for any other type
+func (typedJson *TypedJson) MustTimeOfDay() TimeOfDay {
+	return must(typedJson.TimeOfDay())
+}
+
+// Uuid returns the Value of an UUID type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Uuid() (Uuid, error) {
+	return access[Uuid](typedJson, UUID)
+}
+
+// MustUuid returns the Value of an UUID type and panics for any other type
+func (typedJson *TypedJson) MustUuid() Uuid {
+	return must(typedJson.Uuid())
+}
+
+// Ip returns the Value of an IP type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Ip() (netip.Addr, error) {
+	return access[netip.Addr](typedJson, IP)
+}
+
+// MustIp returns the Value of an IP type and panics for any other type
+func (typedJson *TypedJson) MustIp() netip.Addr {
+	return must(typedJson.Ip())
+}
+
+// IpPrefix returns the Value of an IP_PREFIX type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) IpPrefix() (netip.Prefix, error) {
+	return access[netip.Prefix](typedJson, IP_PREFIX)
+}
+
+// MustIpPrefix returns the Value of an IP_PREFIX type and panics for any other type
+func (typedJson *TypedJson) MustIpPrefix() netip.Prefix {
+	return must(typedJson.IpPrefix())
+}
+
+// AddrPort returns the Value of an ADDR_PORT type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) AddrPort() (netip.AddrPort, error) {
+	return access[netip.AddrPort](typedJson, ADDR_PORT)
+}
+
+// MustAddrPort returns the Value of an ADDR_PORT type and panics for any other type
+func (typedJson *TypedJson) MustAddrPort() netip.AddrPort {
+	return must(typedJson.AddrPort())
+}
+
+// Mac returns the Value of a MAC type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Mac() (net.HardwareAddr, error) {
+	return access[net.HardwareAddr](typedJson, MAC)
+}
+
+// MustMac returns the Value of a MAC type and panics for any other type
+func (typedJson *TypedJson) MustMac() net.HardwareAddr {
+	return must(typedJson.Mac())
+}
+
+// Decimal returns the Value of a DECIMAL type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Decimal() (Decimal, error) {
+	return access[Decimal](typedJson, DECIMAL)
+}
+
+// MustDecimal returns the Value of a DECIMAL type and panics for any other type
+func (typedJson *TypedJson) MustDecimal() Decimal {
+	return must(typedJson.Decimal())
+}
+
+// Url returns the Value of an URL type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Url() (*url.URL, error) {
+	return access[*url.URL](typedJson, URL)
+}
+
+// MustUrl returns the Value of an URL type and panics for any other type
+func (typedJson *TypedJson) MustUrl() *url.URL {
+	return must(typedJson.Url())
+}
+
+// Object returns the Value of an OBJECT type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Object() (map[string]*TypedJson, error) {
+	return access[map[string]*TypedJson](typedJson, OBJECT)
+}
+
+// MustObject returns the Value of an OBJECT type and panics for any other type
+func (typedJson *TypedJson) MustObject() map[string]*TypedJson {
+	return must(typedJson.Object())
+}
+
+// Array returns the Value of an ARRAY type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Array() ([]*TypedJson, error) {
+	return access[[]*TypedJson](typedJson, ARRAY)
+}
+
+// MustArray returns the Value of an ARRAY type and panics for any other type
+func (typedJson *TypedJson) MustArray() []*TypedJson {
+	return must(typedJson.Array())
+}
+
+// Json returns the Value of a JSON type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Json() (json.RawMessage, error) {
+	return access[json.RawMessage](typedJson, JSON)
+}
+
+// MustJson returns the Value of a JSON type and panics for any other type
+func (typedJson *TypedJson) MustJson() json.RawMessage {
+	return must(typedJson.Json())
+}
+
+// Bytes returns the Value of any of the BYTES, BYTES_URL, BYTES_RAW or BYTES_RAW_URL types, or a TypeMismatchError
+// for any other type
+func (typedJson *TypedJson) Bytes() ([]byte, error) {
+	if _, ok := bytesEncodings[typedJson.Type]; ok {
+		return access[[]byte](typedJson, typedJson.Type)
+	}
+
+	return access[[]byte](typedJson, BYTES)
+}
+
+// MustBytes returns the Value of any of the BYTES types and panics for any other type
+func (typedJson *TypedJson) MustBytes() []byte {
+	return must(typedJson.Bytes())
+}
+
+// Ints returns the Value of an INT_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Ints() ([]int, error) {
+	return access[[]int](typedJson, INT_SLICE)
+}
+
+// MustInts returns the Value of an INT_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustInts() []int {
+	return must(typedJson.Ints())
+}
+
+// Int8s returns the Value of an INT8_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Int8s() ([]int8, error) {
+	return access[[]int8](typedJson, INT8_SLICE)
+}
+
+// MustInt8s returns the Value of an INT8_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustInt8s() []int8 {
+	return must(typedJson.Int8s())
+}
+
+// Int16s returns the Value of an INT16_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Int16s() ([]int16, error) {
+	return access[[]int16](typedJson, INT16_SLICE)
+}
+
+// MustInt16s returns the Value of an INT16_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustInt16s() []int16 {
+	return must(typedJson.Int16s())
+}
+
+// Int32s returns the Value of an INT32_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Int32s() ([]int32, error) {
+	return access[[]int32](typedJson, INT32_SLICE)
+}
+
+// MustInt32s returns the Value of an INT32_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustInt32s() []int32 {
+	return must(typedJson.Int32s())
+}
+
+// Int64s returns the Value of an INT64_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Int64s() ([]int64, error) {
+	return access[[]int64](typedJson, INT64_SLICE)
+}
+
+// MustInt64s returns the Value of an INT64_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustInt64s() []int64 {
+	return must(typedJson.Int64s())
+}
+
+// Uints returns the Value of an UINT_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Uints() ([]uint, error) {
+	return access[[]uint](typedJson, UINT_SLICE)
+}
+
+// MustUints returns the Value of an UINT_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustUints() []uint {
+	return must(typedJson.Uints())
+}
+
+// Uint8s returns the Value of an UINT8_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Uint8s() ([]uint8, error) {
+	return access[[]uint8](typedJson, UINT8_SLICE)
+}
+
+// MustUint8s returns the Value of an UINT8_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustUint8s() []uint8 {
+	return must(typedJson.Uint8s())
+}
+
+// Uint16s returns the Value of an UINT16_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Uint16s() ([]uint16, error) {
+	return access[[]uint16](typedJson, UINT16_SLICE)
+}
+
+// MustUint16s returns the Value of an UINT16_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustUint16s() []uint16 {
+	return must(typedJson.Uint16s())
+}
+
+// Uint32s returns the Value of an UINT32_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Uint32s() ([]uint32, error) {
+	return access[[]uint32](typedJson, UINT32_SLICE)
+}
+
+// MustUint32s returns the Value of an UINT32_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustUint32s() []uint32 {
+	return must(typedJson.Uint32s())
+}
+
+// Uint64s returns the Value of an UINT64_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Uint64s() ([]uint64, error) {
+	return access[[]uint64](typedJson, UINT64_SLICE)
+}
+
+// MustUint64s returns the Value of an UINT64_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustUint64s() []uint64 {
+	return must(typedJson.Uint64s())
+}
+
+// Float32s returns the Value of a FLOAT32_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Float32s() ([]float32, error) {
+	return access[[]float32](typedJson, FLOAT32_SLICE)
+}
+
+// MustFloat32s returns the Value of a FLOAT32_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustFloat32s() []float32 {
+	return must(typedJson.Float32s())
+}
+
+// Float64s returns the Value of a FLOAT64_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Float64s() ([]float64, error) {
+	return access[[]float64](typedJson, FLOAT64_SLICE)
+}
+
+// MustFloat64s returns the Value of a FLOAT64_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustFloat64s() []float64 {
+	return must(typedJson.Float64s())
+}
+
+// Strings returns the Value of a STRING_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Strings() ([]string, error) {
+	return access[[]string](typedJson, STRING_SLICE)
+}
+
+// MustStrings returns the Value of a STRING_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustStrings() []string {
+	return must(typedJson.Strings())
+}
+
+// Bools returns the Value of a BOOL_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Bools() ([]bool, error) {
+	return access[[]bool](typedJson, BOOL_SLICE)
+}
+
+// MustBools returns the Value of a BOOL_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustBools() []bool {
+	return must(typedJson.Bools())
+}
+
+// Times returns the Value of a DATETIME_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Times() ([]time.Time, error) {
+	return access[[]time.Time](typedJson, DATETIME_SLICE)
+}
+
+// MustTimes returns the Value of a DATETIME_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustTimes() []time.Time {
+	return must(typedJson.Times())
+}
+
+// Durations returns the Value of a TIME_DURATION_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Durations() ([]time.Duration, error) {
+	return access[[]time.Duration](typedJson, TIME_DURATION_SLICE)
+}
+
+// MustDurations returns the Value of a TIME_DURATION_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustDurations() []time.Duration {
+	return must(typedJson.Durations())
+}
+
+// Complex64s returns the Value of a COMPLEX64_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Complex64s() ([]complex64, error) {
+	return access[[]complex64](typedJson, COMPLEX64_SLICE)
+}
+
+// MustComplex64s returns the Value of a COMPLEX64_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustComplex64s() []complex64 {
+	return must(typedJson.Complex64s())
+}
+
+// Complex128s returns the Value of a COMPLEX128_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Complex128s() ([]complex128, error) {
+	return access[[]complex128](typedJson, COMPLEX128_SLICE)
+}
+
+// MustComplex128s returns the Value of a COMPLEX128_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustComplex128s() []complex128 {
+	return must(typedJson.Complex128s())
+}
+
+// BigInts returns the Value of a BIG_INT_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) BigInts() ([]*big.Int, error) {
+	return access[[]*big.Int](typedJson, BIG_INT_SLICE)
+}
+
+// MustBigInts returns the Value of a BIG_INT_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustBigInts() []*big.Int {
+	return must(typedJson.BigInts())
+}
+
+// BigFloats returns the Value of a BIG_FLOAT_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) BigFloats() ([]*big.Float, error) {
+	return access[[]*big.Float](typedJson, BIG_FLOAT_SLICE)
+}
+
+// MustBigFloats returns the Value of a BIG_FLOAT_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustBigFloats() []*big.Float {
+	return must(typedJson.BigFloats())
+}
+
+// BigRats returns the Value of a BIG_RAT_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) BigRats() ([]*big.Rat, error) {
+	return access[[]*big.Rat](typedJson, BIG_RAT_SLICE)
+}
+
+// MustBigRats returns the Value of a BIG_RAT_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustBigRats() []*big.Rat {
+	return must(typedJson.BigRats())
+}
+
+// Int128s returns the Value of an INT128_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Int128s() ([]Int128, error) {
+	return access[[]Int128](typedJson, INT128_SLICE)
+}
+
+// MustInt128s returns the Value of an INT128_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustInt128s() []Int128 {
+	return must(typedJson.Int128s())
+}
+
+// Uint128s returns the Value of an UINT128_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Uint128s() ([]Uint128, error) {
+	return access[[]Uint128](typedJson, UINT128_SLICE)
+}
+
+// MustUint128s returns the Value of an UINT128_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustUint128s() []Uint128 {
+	return must(typedJson.Uint128s())
+}
+
+// Dates returns the Value of a DATE_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Dates() ([]Date, error) {
+	return access[[]Date](typedJson, DATE_SLICE)
+}
+
+// MustDates returns the Value of a DATE_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustDates() []Date {
+	return must(typedJson.Dates())
+}
+
+// TimeOfDays returns the Value of a TIME_OF_DAY_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) TimeOfDays() ([]TimeOfDay, error) {
+	return access[[]TimeOfDay](typedJson, TIME_OF_DAY_SLICE)
+}
+
+// MustTimeOfDays returns the Value of a TIME_OF_DAY_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustTimeOfDays() []TimeOfDay {
+	return must(typedJson.TimeOfDays())
+}
+
+// Uuids returns the Value of an UUID_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Uuids() ([]Uuid, error) {
+	return access[[]Uuid](typedJson, UUID_SLICE)
+}
+
+// MustUuids returns the Value of an UUID_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustUuids() []Uuid {
+	return must(typedJson.Uuids())
+}
+
+// Ips returns the Value of an IP_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Ips() ([]netip.Addr, error) {
+	return access[[]netip.Addr](typedJson, IP_SLICE)
+}
+
+// MustIps returns the Value of an IP_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustIps() []netip.Addr {
+	return must(typedJson.Ips())
+}
+
+// IpPrefixes returns the Value of an IP_PREFIX_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) IpPrefixes() ([]netip.Prefix, error) {
+	return access[[]netip.Prefix](typedJson, IP_PREFIX_SLICE)
+}
+
+// MustIpPrefixes returns the Value of an IP_PREFIX_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustIpPrefixes() []netip.Prefix {
+	return must(typedJson.IpPrefixes())
+}
+
+// AddrPorts returns the Value of an ADDR_PORT_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) AddrPorts() ([]netip.AddrPort, error) {
+	return access[[]netip.AddrPort](typedJson, ADDR_PORT_SLICE)
+}
+
+// MustAddrPorts returns the Value of an ADDR_PORT_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustAddrPorts() []netip.AddrPort {
+	return must(typedJson.AddrPorts())
+}
+
+// Macs returns the Value of a MAC_SLICE type, or a TypeMismatchError for any other type
+func (typedJson *TypedJson) Macs() ([]net.HardwareAddr, error) {
+	return access[[]net.HardwareAddr](typedJson, MAC_SLICE)
+}
+
+// MustMacs returns the Value of a MAC_SLICE type and panics for any other type
+func (typedJson *TypedJson) MustMacs() []net.HardwareAddr {
+	return must(typedJson.Macs())
+}
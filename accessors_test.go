@@ -0,0 +1,88 @@
+package gotypedjson_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	gotypedjson "github.com/DanLavine/go-typed-json"
+	. "github.com/onsi/gomega"
+)
+
+func Test_Accessors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("It returns a TypeMismatchError for a different type", func(t *testing.T) {
+		tJson := gotypedjson.NewTypedJson(gotypedjson.INT32, int32(5), nil)
+
+		_, err := tJson.Int64()
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("failed to access type '_int32' as type '_int64'"))
+
+		mismatch := &gotypedjson.TypeMismatchError{}
+		g.Expect(errors.As(err, &mismatch)).To(BeTrue())
+		g.Expect(mismatch.Stored).To(Equal(gotypedjson.INT32))
+		g.Expect(mismatch.Requested).To(Equal(gotypedjson.INT64))
+	})
+
+	t.Run("It returns an error when the Value does not match the type", func(t *testing.T) {
+		tJson := gotypedjson.NewTypedJson(gotypedjson.INT64, "5", nil)
+
+		_, err := tJson.Int64()
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("failed to cast '5' to a int64"))
+	})
+
+	t.Run("It returns the zero value for a nil Value", func(t *testing.T) {
+		tJson := gotypedjson.NewTypedJson(gotypedjson.STRING_SLICE, nil, nil)
+
+		value, err := tJson.Strings()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(value).To(BeNil())
+	})
+
+	t.Run("It returns the decoded values", func(t *testing.T) {
+		tJson := gotypedjson.NewTypedJsonDecoder(nil)
+		g.Expect(json.Unmarshal([]byte(`{"Type":"_uint32","Value":"7"}`), tJson)).ToNot(HaveOccurred())
+
+		value, err := tJson.Uint32()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(value).To(Equal(uint32(7)))
+
+		g.Expect(json.Unmarshal([]byte(`{"Type":"_duration","Value":"1m30s"}`), tJson)).ToNot(HaveOccurred())
+		g.Expect(tJson.MustDuration()).To(Equal(90 * time.Second))
+
+		g.Expect(json.Unmarshal([]byte(`{"Type":"_datetime","Value":"2024-01-02T03:04:05Z"}`), tJson)).ToNot(HaveOccurred())
+		g.Expect(tJson.MustTime()).To(Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)))
+
+		g.Expect(json.Unmarshal([]byte(`{"Type":"_string_array","Value":"YQ==,Yg=="}`), tJson)).ToNot(HaveOccurred())
+		g.Expect(tJson.MustStrings()).To(Equal([]string{"a", "b"}))
+	})
+
+	t.Run("It returns the types added to the defaults", func(t *testing.T) {
+		decimal := gotypedjson.NewDecimal(1050, 2)
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.DECIMAL, decimal, nil).MustDecimal()).To(Equal(decimal))
+
+		uuids := []gotypedjson.Uuid{{1}}
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.UUID_SLICE, uuids, nil).MustUuids()).To(Equal(uuids))
+
+		objects := map[string]*gotypedjson.TypedJson{"a": nil}
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.OBJECT, objects, nil).MustObject()).To(Equal(objects))
+	})
+
+	t.Run("It returns the value of any bytes type", func(t *testing.T) {
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.BYTES_RAW_URL, []byte{1}, nil).MustBytes()).To(Equal([]byte{1}))
+
+		_, err := gotypedjson.NewTypedJson(gotypedjson.UINT8_SLICE, []byte{1}, nil).Bytes()
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("failed to access type '_uint8_array' as type '_bytes'"))
+	})
+
+	t.Run("It panics on a mismatch with the Must accessors", func(t *testing.T) {
+		tJson := gotypedjson.NewTypedJson(gotypedjson.BOOL, true, nil)
+
+		g.Expect(tJson.MustBool()).To(BeTrue())
+		g.Expect(func() { tJson.MustStringValue() }).To(Panic())
+	})
+}
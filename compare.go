@@ -0,0 +1,463 @@
+package gotypedjson
+
+import (
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"math"
+	"math/big"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TypedComparable can be implemented by custom types to control how their values are compared and hashed by the
+// Equal, Compare and Hash methods. Custom types that do not implement it are compared field by field.
+type TypedComparable interface {
+	// TypedCompare returns -1, 0 or +1 when the value is less than, equal to or greater than other. other always has
+	// the same Go type as the value
+	TypedCompare(other any) int
+
+	// TypedHash returns a hash that is the same for any two values where TypedCompare returns 0
+	TypedHash() uint64
+}
+
+// typedComparable is the reflect type of the TypedComparable interface
+var typedComparable = reflect.TypeFor[TypedComparable]()
+
+// numericScalars are the types whose values are compared by their numeric value, even across types
+var numericScalars = map[JSONTYPE]bool{
+	INT: true, INT8: true, INT16: true, INT32: true, INT64: true,
+	UINT: true, UINT8: true, UINT16: true, UINT32: true, UINT64: true,
+	FLOAT32: true, FLOAT64: true, BIG_INT: true, BIG_FLOAT: true, BIG_RAT: true,
+	INT128: true, UINT128: true, DECIMAL: true,
+}
+
+// number is the exact value of any of the numeric scalar types
+type number struct {
+	// rank orders the special values: NaN is 0, -Inf is 1, finite numbers are 2 and +Inf is 3
+	rank int
+	// rat is the exact value of a finite number
+	rat *big.Rat
+}
+
+// numberOf returns the exact value of a numeric scalar type. The boolean is false for any other type, or a Value
+// that is nil or does not match the type.
+func (typedJson *TypedJson) numberOf() (number, bool) {
+	if !numericScalars[typedJson.Type] || isNil(typedJson.Value) || reflect.TypeOf(typedJson.Value) != scalarTypes[typedJson.Type] {
+		return number{}, false
+	}
+
+	switch value := typedJson.Value.(type) {
+	case float32:
+		return floatNumber(float64(value)), true
+	case float64:
+		return floatNumber(value), true
+	case *big.Int:
+		return number{rank: 2, rat: new(big.Rat).SetInt(value)}, true
+	case *big.Float:
+		if value.IsInf() {
+			return number{rank: 2 + value.Sign()}, true
+		}
+
+		rat, _ := value.Rat(nil)
+		return number{rank: 2, rat: rat}, true
+	case *big.Rat:
+		return number{rank: 2, rat: new(big.Rat).Set(value)}, true
+	case Int128:
+		return number{rank: 2, rat: new(big.Rat).SetInt(value.BigInt())}, true
+	case Uint128:
+		return number{rank: 2, rat: new(big.Rat).SetInt(value.BigInt())}, true
+	case Decimal:
+		return number{rank: 2, rat: value.Rat()}, true
+	}
+
+	integer := reflect.ValueOf(typedJson.Value)
+	if integer.CanInt() {
+		return number{rank: 2, rat: new(big.Rat).SetInt64(integer.Int())}, true
+	}
+
+	return number{rank: 2, rat: new(big.Rat).SetUint64(integer.Uint())}, true
+}
+
+// floatNumber returns the exact value of a float
+func floatNumber(value float64) number {
+	switch {
+	case math.IsNaN(value):
+		return number{rank: 0}
+	case math.IsInf(value, 0):
+		return number{rank: 2 + int(math.Copysign(1, value))}
+	default:
+		return number{rank: 2, rat: new(big.Rat).SetFloat64(value)}
+	}
+}
+
+// compare orders numbers as NaN, -Inf, finite numbers and then +Inf
+func (value number) compare(other number) int {
+	if value.rank != other.rank {
+		return cmp.Compare(value.rank, other.rank)
+	}
+
+	if value.rank != 2 {
+		return 0
+	}
+
+	return value.rat.Cmp(other.rat)
+}
+
+// String is the canonical form of the number used when hashing
+func (value number) String() string {
+	switch value.rank {
+	case 0:
+		return "NaN"
+	case 1:
+		return "-Inf"
+	case 3:
+		return "+Inf"
+	default:
+		return value.rat.RatString()
+	}
+}
+
+//	PARAMETERS:
+//	* other - TypedJson to compare against
+//
+//	RETURNS:
+//	* bool - true when both have the same Type and equal Values
+//
+// Equal reports if two TypedJson are the same Type with equal Values. This is the same as Compare returning 0, so
+// times are equal when they are the same instant in any location, NaN is equal to NaN and a nil TypedJson is only
+// equal to another nil TypedJson.
+func (typedJson *TypedJson) Equal(other *TypedJson) bool {
+	return typedJson.Compare(other) == 0
+}
+
+//	PARAMETERS:
+//	* other - TypedJson to compare against
+//
+//	RETURNS:
+//	* int - -1 if typedJson < other, 0 if typedJson == other and +1 if typedJson > other
+//
+// Compare is a total order over all TypedJson values that can be used for sorting. The order is:
+//  1. nil TypedJson values
+//  2. numeric scalars (integers, floats, big numbers, Int128, Uint128 and Decimal) ordered by their value across
+//     all types with NaN first. Equal values of different types are then ordered by their Type
+//  3. all other values ordered by their Type and then by their Value, where nil Values are first
+//
+// Values of the same type are compared the natural way for their Go type. Slices are compared element by element,
+// maps by their sorted keys and then values, and times by their instant. Custom types can implement TypedComparable,
+// otherwise they are compared field by field.
+func (typedJson *TypedJson) Compare(other *TypedJson) int {
+	if typedJson == nil || other == nil {
+		return compareBool(typedJson != nil, other != nil)
+	}
+
+	value, valueNumeric := typedJson.numberOf()
+	otherValue, otherNumeric := other.numberOf()
+	if valueNumeric != otherNumeric {
+		return compareBool(otherNumeric, valueNumeric)
+	}
+
+	if valueNumeric {
+		if compared := value.compare(otherValue); compared != 0 {
+			return compared
+		}
+
+		return strings.Compare(string(typedJson.Type), string(other.Type))
+	}
+
+	if compared := strings.Compare(string(typedJson.Type), string(other.Type)); compared != 0 {
+		return compared
+	}
+
+	if isNil(typedJson.Value) || isNil(other.Value) {
+		return compareBool(!isNil(typedJson.Value), !isNil(other.Value))
+	}
+
+	values, otherValues := reflect.ValueOf(typedJson.Value), reflect.ValueOf(other.Value)
+	if values.Type() != otherValues.Type() {
+		return strings.Compare(values.Type().String(), otherValues.Type().String())
+	}
+
+	return compareValues(values, otherValues)
+}
+
+//	RETURNS:
+//	* uint64 - hash of the Type and Value
+//
+// Hash returns a hash that is the same for any two TypedJson values that are Equal, so they can be used as map keys
+// or to remove duplicates. The hash is stable across processes and versions of Go for the same values.
+func (typedJson *TypedJson) Hash() uint64 {
+	hasher := fnv.New64a()
+	typedJson.hash(hasher)
+
+	return hasher.Sum64()
+}
+
+// hash writes the Type and Value to the hasher
+func (typedJson *TypedJson) hash(hasher hash.Hash64) {
+	if typedJson == nil {
+		hashString(hasher, "nil")
+		return
+	}
+
+	hashString(hasher, string(typedJson.Type))
+
+	if value, ok := typedJson.numberOf(); ok {
+		hashString(hasher, value.String())
+		return
+	}
+
+	if isNil(typedJson.Value) {
+		hashString(hasher, "null")
+		return
+	}
+
+	hashString(hasher, reflect.TypeOf(typedJson.Value).String())
+	hashValue(hasher, reflect.ValueOf(typedJson.Value))
+}
+
+// compareValues compares two values with the same Go type
+func compareValues(value, other reflect.Value) int {
+	if value.CanInterface() && value.Type().Implements(typedComparable) {
+		if value.Kind() == reflect.Pointer && (value.IsNil() || other.IsNil()) {
+			return compareBool(!value.IsNil(), !other.IsNil())
+		}
+
+		return value.Interface().(TypedComparable).TypedCompare(other.Interface())
+	}
+
+	if value.CanInterface() {
+		switch typed := value.Interface().(type) {
+		case *TypedJson:
+			return typed.Compare(other.Interface().(*TypedJson))
+		case time.Time:
+			return typed.Compare(other.Interface().(time.Time))
+		case Decimal:
+			return typed.Cmp(other.Interface().(Decimal))
+		case netip.Addr:
+			return typed.Compare(other.Interface().(netip.Addr))
+		case netip.AddrPort:
+			return typed.Compare(other.Interface().(netip.AddrPort))
+		case *big.Int, *big.Float, *big.Rat, *url.URL:
+			if value.IsNil() || other.IsNil() {
+				return compareBool(!value.IsNil(), !other.IsNil())
+			}
+
+			switch typed := typed.(type) {
+			case *big.Int:
+				return typed.Cmp(other.Interface().(*big.Int))
+			case *big.Float:
+				return typed.Cmp(other.Interface().(*big.Float))
+			case *big.Rat:
+				return typed.Cmp(other.Interface().(*big.Rat))
+			default:
+				return strings.Compare(typed.(*url.URL).String(), other.Interface().(*url.URL).String())
+			}
+		case netip.Prefix:
+			otherPrefix := other.Interface().(netip.Prefix)
+			if compared := typed.Addr().Compare(otherPrefix.Addr()); compared != 0 {
+				return compared
+			}
+
+			return cmp.Compare(typed.Bits(), otherPrefix.Bits())
+		}
+	}
+
+	switch value.Kind() {
+	case reflect.Bool:
+		return compareBool(value.Bool(), other.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(value.Int(), other.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(value.Uint(), other.Uint())
+	case reflect.Float32, reflect.Float64:
+		return floatNumber(value.Float()).compare(floatNumber(other.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		if compared := floatNumber(real(value.Complex())).compare(floatNumber(real(other.Complex()))); compared != 0 {
+			return compared
+		}
+
+		return floatNumber(imag(value.Complex())).compare(floatNumber(imag(other.Complex())))
+	case reflect.String:
+		return strings.Compare(value.String(), other.String())
+	case reflect.Slice, reflect.Array:
+		for index := range min(value.Len(), other.Len()) {
+			if compared := compareValues(value.Index(index), other.Index(index)); compared != 0 {
+				return compared
+			}
+		}
+
+		return cmp.Compare(value.Len(), other.Len())
+	case reflect.Map:
+		keys, otherKeys := sortedKeys(value), sortedKeys(other)
+		for index := range min(len(keys), len(otherKeys)) {
+			if compared := compareValues(keys[index], otherKeys[index]); compared != 0 {
+				return compared
+			}
+
+			if compared := compareValues(value.MapIndex(keys[index]), other.MapIndex(otherKeys[index])); compared != 0 {
+				return compared
+			}
+		}
+
+		return cmp.Compare(len(keys), len(otherKeys))
+	case reflect.Struct:
+		for index := range value.NumField() {
+			if compared := compareValues(value.Field(index), other.Field(index)); compared != 0 {
+				return compared
+			}
+		}
+
+		return 0
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() || other.IsNil() {
+			return compareBool(!value.IsNil(), !other.IsNil())
+		}
+
+		if value.Elem().Type() != other.Elem().Type() {
+			return strings.Compare(value.Elem().Type().String(), other.Elem().Type().String())
+		}
+
+		return compareValues(value.Elem(), other.Elem())
+	default:
+		// functions and channels can only be compared by their address
+		return cmp.Compare(value.Pointer(), other.Pointer())
+	}
+}
+
+// hashValue writes a value to the hasher so that any two values where compareValues returns 0 are written the same
+func hashValue(hasher hash.Hash64, value reflect.Value) {
+	if value.CanInterface() && value.Type().Implements(typedComparable) {
+		if value.Kind() == reflect.Pointer && value.IsNil() {
+			hashString(hasher, "nil")
+			return
+		}
+
+		hashUint(hasher, value.Interface().(TypedComparable).TypedHash())
+		return
+	}
+
+	if value.CanInterface() {
+		switch typed := value.Interface().(type) {
+		case *TypedJson:
+			typed.hash(hasher)
+			return
+		case time.Time:
+			hashUint(hasher, uint64(typed.Unix()))
+			hashUint(hasher, uint64(typed.Nanosecond()))
+			return
+		case Decimal:
+			hashString(hasher, typed.Rat().RatString())
+			return
+		case netip.Addr, netip.AddrPort, netip.Prefix:
+			hashString(hasher, typed.(fmt.Stringer).String())
+			return
+		case *big.Int, *big.Float, *big.Rat, *url.URL:
+			if value.IsNil() {
+				hashString(hasher, "nil")
+				return
+			}
+
+			switch typed := typed.(type) {
+			case *big.Int:
+				hashString(hasher, typed.String())
+			case *big.Float:
+				if typed.IsInf() {
+					hashString(hasher, typed.String())
+					return
+				}
+
+				rat, _ := typed.Rat(nil)
+				hashString(hasher, rat.RatString())
+			case *big.Rat:
+				hashString(hasher, typed.RatString())
+			default:
+				hashString(hasher, typed.(*url.URL).String())
+			}
+
+			return
+		}
+	}
+
+	switch value.Kind() {
+	case reflect.Bool:
+		if value.Bool() {
+			hashUint(hasher, 1)
+		} else {
+			hashUint(hasher, 0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		hashUint(hasher, uint64(value.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		hashUint(hasher, value.Uint())
+	case reflect.Float32, reflect.Float64:
+		hashString(hasher, floatNumber(value.Float()).String())
+	case reflect.Complex64, reflect.Complex128:
+		hashString(hasher, floatNumber(real(value.Complex())).String())
+		hashString(hasher, floatNumber(imag(value.Complex())).String())
+	case reflect.String:
+		hashString(hasher, value.String())
+	case reflect.Slice, reflect.Array:
+		hashUint(hasher, uint64(value.Len()))
+		for index := range value.Len() {
+			hashValue(hasher, value.Index(index))
+		}
+	case reflect.Map:
+		hashUint(hasher, uint64(value.Len()))
+		for _, key := range sortedKeys(value) {
+			hashValue(hasher, key)
+			hashValue(hasher, value.MapIndex(key))
+		}
+	case reflect.Struct:
+		for index := range value.NumField() {
+			hashValue(hasher, value.Field(index))
+		}
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			hashString(hasher, "nil")
+			return
+		}
+
+		hashString(hasher, value.Elem().Type().String())
+		hashValue(hasher, value.Elem())
+	default:
+		hashUint(hasher, uint64(value.Pointer()))
+	}
+}
+
+// sortedKeys returns the keys of a map in the order used by compareValues
+func sortedKeys(value reflect.Value) []reflect.Value {
+	keys := value.MapKeys()
+	sort.Slice(keys, func(i, j int) bool { return compareValues(keys[i], keys[j]) < 0 })
+
+	return keys
+}
+
+// hashString writes a length prefixed string to the hasher
+func hashString(hasher hash.Hash64, value string) {
+	hashUint(hasher, uint64(len(value)))
+	hasher.Write([]byte(value))
+}
+
+// hashUint writes a fixed size integer to the hasher
+func hashUint(hasher hash.Hash64, value uint64) {
+	hasher.Write(binary.BigEndian.AppendUint64(nil, value))
+}
+
+// compareBool orders false before true
+func compareBool(value, other bool) int {
+	switch {
+	case value == other:
+		return 0
+	case value:
+		return 1
+	default:
+		return -1
+	}
+}
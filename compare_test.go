@@ -0,0 +1,162 @@
+package gotypedjson_test
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	gotypedjson "github.com/DanLavine/go-typed-json"
+	. "github.com/onsi/gomega"
+)
+
+// testCaseless is compared and hashed without regard to case
+type testCaseless string
+
+func (caseless testCaseless) TypedCompare(other any) int {
+	return strings.Compare(strings.ToLower(string(caseless)), strings.ToLower(string(other.(testCaseless))))
+}
+
+func (caseless testCaseless) TypedHash() uint64 {
+	return uint64(len(caseless))
+}
+
+func Test_Equal(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("It requires the same Type", func(t *testing.T) {
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.INT32, int32(5), nil).Equal(gotypedjson.NewTypedJson(gotypedjson.INT64, int64(5), nil))).To(BeFalse())
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.INT64, int64(5), nil).Equal(gotypedjson.NewTypedJson(gotypedjson.INT64, int64(5), nil))).To(BeTrue())
+	})
+
+	t.Run("It compares nil values", func(t *testing.T) {
+		var nilJson *gotypedjson.TypedJson
+		g.Expect(nilJson.Equal(nil)).To(BeTrue())
+		g.Expect(nilJson.Equal(gotypedjson.NewTypedJson(gotypedjson.INT, nil, nil))).To(BeFalse())
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.INT, nil, nil).Equal(gotypedjson.NewTypedJson(gotypedjson.INT, nil, nil))).To(BeTrue())
+	})
+
+	t.Run("It compares times by their instant", func(t *testing.T) {
+		utc := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		local := utc.In(time.FixedZone("plus", 3600))
+
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.DATETIME, utc, nil).Equal(gotypedjson.NewTypedJson(gotypedjson.DATETIME, local, nil))).To(BeTrue())
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.DATETIME, utc, nil).Hash()).To(Equal(gotypedjson.NewTypedJson(gotypedjson.DATETIME, local, nil).Hash()))
+	})
+
+	t.Run("It treats NaN as equal to NaN", func(t *testing.T) {
+		nan := gotypedjson.NewTypedJson(gotypedjson.FLOAT64_SLICE, []float64{math.NaN()}, nil)
+		g.Expect(nan.Equal(gotypedjson.NewTypedJson(gotypedjson.FLOAT64_SLICE, []float64{math.NaN()}, nil))).To(BeTrue())
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.FLOAT64, math.NaN(), nil).Equal(gotypedjson.NewTypedJson(gotypedjson.FLOAT64, math.NaN(), nil))).To(BeTrue())
+	})
+
+	t.Run("It compares decimals by their value", func(t *testing.T) {
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.DECIMAL, gotypedjson.NewDecimal(1050, 2), nil).Equal(gotypedjson.NewTypedJson(gotypedjson.DECIMAL, gotypedjson.NewDecimal(105, 1), nil))).To(BeTrue())
+	})
+
+	t.Run("It compares nested values", func(t *testing.T) {
+		object := func(value int) *gotypedjson.TypedJson {
+			return gotypedjson.NewTypedJson(gotypedjson.OBJECT, map[string]*gotypedjson.TypedJson{
+				"a": gotypedjson.NewTypedJson(gotypedjson.ARRAY, []*gotypedjson.TypedJson{gotypedjson.NewTypedJson(gotypedjson.INT, value, nil), nil}, nil),
+			}, nil)
+		}
+
+		g.Expect(object(1).Equal(object(1))).To(BeTrue())
+		g.Expect(object(1).Equal(object(2))).To(BeFalse())
+		g.Expect(object(1).Hash()).To(Equal(object(1).Hash()))
+	})
+
+	t.Run("It uses TypedComparable for custom types", func(t *testing.T) {
+		upper := gotypedjson.NewTypedJson("caseless", testCaseless("ABC"), nil)
+		lower := gotypedjson.NewTypedJson("caseless", testCaseless("abc"), nil)
+
+		g.Expect(upper.Equal(lower)).To(BeTrue())
+		g.Expect(upper.Hash()).To(Equal(lower.Hash()))
+	})
+}
+
+func Test_Compare(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("It orders numbers by value across types", func(t *testing.T) {
+		values := []*gotypedjson.TypedJson{
+			gotypedjson.NewTypedJson(gotypedjson.STRING, "a", nil),
+			gotypedjson.NewTypedJson(gotypedjson.UINT64, uint64(math.MaxUint64), nil),
+			gotypedjson.NewTypedJson(gotypedjson.FLOAT64, math.Inf(1), nil),
+			gotypedjson.NewTypedJson(gotypedjson.INT8, int8(-3), nil),
+			gotypedjson.NewTypedJson(gotypedjson.BIG_RAT, big.NewRat(1, 3), nil),
+			gotypedjson.NewTypedJson(gotypedjson.FLOAT32, float32(math.NaN()), nil),
+			gotypedjson.NewTypedJson(gotypedjson.INT64, int64(-3), nil),
+			gotypedjson.NewTypedJson(gotypedjson.DECIMAL, gotypedjson.NewDecimal(25, 2), nil),
+			nil,
+		}
+
+		sort.Slice(values, func(i, j int) bool { return values[i].Compare(values[j]) < 0 })
+
+		types := []gotypedjson.JSONTYPE{}
+		for _, value := range values[1:] {
+			types = append(types, value.Type)
+		}
+
+		g.Expect(values[0]).To(BeNil())
+		g.Expect(types).To(Equal([]gotypedjson.JSONTYPE{
+			gotypedjson.FLOAT32, gotypedjson.INT64, gotypedjson.INT8, gotypedjson.DECIMAL, gotypedjson.BIG_RAT,
+			gotypedjson.UINT64, gotypedjson.FLOAT64, gotypedjson.STRING,
+		}))
+	})
+
+	t.Run("It orders other values by Type and then Value", func(t *testing.T) {
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.BOOL, true, nil).Compare(gotypedjson.NewTypedJson(gotypedjson.STRING, "a", nil))).To(Equal(-1))
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.STRING, "b", nil).Compare(gotypedjson.NewTypedJson(gotypedjson.STRING, "a", nil))).To(Equal(1))
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.STRING, nil, nil).Compare(gotypedjson.NewTypedJson(gotypedjson.STRING, "", nil))).To(Equal(-1))
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.TIME_DURATION, time.Second, nil).Compare(gotypedjson.NewTypedJson(gotypedjson.TIME_DURATION, time.Minute, nil))).To(Equal(-1))
+	})
+
+	t.Run("It orders slices element by element", func(t *testing.T) {
+		short := gotypedjson.NewTypedJson(gotypedjson.INT_SLICE, []int{1, 2}, nil)
+		long := gotypedjson.NewTypedJson(gotypedjson.INT_SLICE, []int{1, 2, 0}, nil)
+		larger := gotypedjson.NewTypedJson(gotypedjson.INT_SLICE, []int{1, 3}, nil)
+
+		g.Expect(short.Compare(long)).To(Equal(-1))
+		g.Expect(long.Compare(larger)).To(Equal(-1))
+		g.Expect(larger.Compare(short)).To(Equal(1))
+	})
+
+	t.Run("It orders maps by their sorted keys and values", func(t *testing.T) {
+		first := gotypedjson.NewTypedJson(gotypedjson.STRING_INT_MAP, map[string]int{"a": 1, "b": 2}, nil)
+		second := gotypedjson.NewTypedJson(gotypedjson.STRING_INT_MAP, map[string]int{"b": 1, "a": 2}, nil)
+
+		g.Expect(first.Compare(second)).To(Equal(-1))
+		g.Expect(first.Hash()).ToNot(Equal(second.Hash()))
+	})
+}
+
+func Test_Hash(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("It is stable for the same value", func(t *testing.T) {
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.STRING, "a", nil).Hash()).To(Equal(uint64(0xa1b3a4ed100871fb)))
+	})
+
+	t.Run("It includes the Type", func(t *testing.T) {
+		g.Expect(gotypedjson.NewTypedJson(gotypedjson.INT32, int32(5), nil).Hash()).ToNot(Equal(gotypedjson.NewTypedJson(gotypedjson.INT64, int64(5), nil).Hash()))
+	})
+
+	t.Run("It can deduplicate values", func(t *testing.T) {
+		unique := map[uint64]*gotypedjson.TypedJson{}
+		for _, value := range []*gotypedjson.TypedJson{
+			gotypedjson.NewTypedJson(gotypedjson.BIG_FLOAT, big.NewFloat(0.5), nil),
+			gotypedjson.NewTypedJson(gotypedjson.BIG_FLOAT, new(big.Float).SetPrec(200).SetFloat64(0.5), nil),
+			gotypedjson.NewTypedJson(gotypedjson.FLOAT64, -0.0, nil),
+			gotypedjson.NewTypedJson(gotypedjson.FLOAT64, 0.0, nil),
+			gotypedjson.NewTypedJson(gotypedjson.STRING_SLICE, []string{"a"}, nil),
+			gotypedjson.NewTypedJson(gotypedjson.STRING_SLICE, []string{"a"}, nil),
+		} {
+			unique[value.Hash()] = value
+		}
+
+		g.Expect(unique).To(HaveLen(3))
+	})
+}
@@ -0,0 +1,243 @@
+package gotypedjson
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+var (
+	// ErrOverflow is returned when a converted value is out of range for the target type
+	ErrOverflow = errors.New("value overflows the target type")
+	// ErrPrecisionLoss is returned when a converted value can not be represented exactly by the target type
+	ErrPrecisionLoss = errors.New("value loses precision in the target type")
+	// ErrSignLoss is returned when a negative value is converted to an unsigned type
+	ErrSignLoss = errors.New("value loses its sign in the target type")
+)
+
+// numericTypes are the types that can be converted between each other, along with any slices of them
+var numericTypes = map[JSONTYPE]bool{
+	INT: true, INT8: true, INT16: true, INT32: true, INT64: true,
+	UINT: true, UINT8: true, UINT16: true, UINT32: true, UINT64: true,
+	FLOAT32: true, FLOAT64: true, COMPLEX64: true, COMPLEX128: true,
+}
+
+// numericType returns the numeric type and the number of slice dimensions for a type. The boolean is false when
+// the type is not a numeric type or a slice of one.
+func numericType(jsonType JSONTYPE) (JSONTYPE, int, bool) {
+	dimensions := 0
+	for {
+		if numericTypes[jsonType] {
+			return jsonType, dimensions, true
+		}
+
+		trimmed, ok := strings.CutSuffix(string(jsonType), "_array")
+		if !ok {
+			return "", 0, false
+		}
+
+		jsonType = JSONTYPE(trimmed)
+		dimensions++
+	}
+}
+
+//	PARAMETERS:
+//	* target - Type to convert the Value to
+//
+//	RETURNS:
+//	* *TypedJson - new TypedJson with the target Type and converted Value
+//	* error      - error if the conversion is unsupported or would lose information
+//
+// Convert returns a copy of the TypedJson converted to the target type. Conversions are supported between any of
+// the integer, float and complex types, along with slices of them that have the same number of dimensions. A value
+// is only converted when it is represented exactly by the target type, otherwise the error wraps one of ErrOverflow,
+// ErrPrecisionLoss or ErrSignLoss. For example an INT64 of 5 can be converted to an INT8, but 300 can not.
+func (typedJson *TypedJson) Convert(target JSONTYPE) (*TypedJson, error) {
+	source, sourceDimensions, sourceOk := numericType(typedJson.Type)
+	element, targetDimensions, targetOk := numericType(target)
+	if !sourceOk || !targetOk || sourceDimensions != targetDimensions {
+		return nil, fmt.Errorf("failed to convert type '%s' to '%s': unsupported conversion", typedJson.Type, target)
+	}
+
+	converted := &TypedJson{Type: target, customCodec: typedJson.customCodec}
+	if isNil(typedJson.Value) {
+		return converted, nil
+	}
+
+	sourceType, targetType := scalarTypes[source], scalarTypes[element]
+	for range sourceDimensions {
+		sourceType, targetType = reflect.SliceOf(sourceType), reflect.SliceOf(targetType)
+	}
+
+	value := reflect.ValueOf(typedJson.Value)
+	if value.Type() != sourceType {
+		return nil, fmt.Errorf("failed to cast '%v' to a %s", typedJson.Value, sourceType)
+	}
+
+	convertedValue, err := convertValue(value, targetType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert type '%s' to '%s': %w", typedJson.Type, target, err)
+	}
+
+	converted.Value = convertedValue.Interface()
+	return converted, nil
+}
+
+// convertValue converts a numeric value or a slice of numeric values to the target Go type
+func convertValue(value reflect.Value, target reflect.Type) (reflect.Value, error) {
+	if value.Kind() == reflect.Slice {
+		if value.IsNil() {
+			return reflect.Zero(target), nil
+		}
+
+		converted := reflect.MakeSlice(target, value.Len(), value.Len())
+		for index := range value.Len() {
+			element, err := convertValue(value.Index(index), target.Elem())
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("index %d: %w", index, err)
+			}
+
+			converted.Index(index).Set(element)
+		}
+
+		return converted, nil
+	}
+
+	converted := reflect.New(target).Elem()
+	if err := convertNumber(value, converted); err != nil {
+		return reflect.Value{}, fmt.Errorf("'%v': %w", value.Interface(), err)
+	}
+
+	return converted, nil
+}
+
+// convertNumber sets the converted value of a number, returning an error if it is not represented exactly
+func convertNumber(value reflect.Value, converted reflect.Value) error {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return setExact(new(big.Float).SetInt64(value.Int()), converted)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return setExact(new(big.Float).SetUint64(value.Uint()), converted)
+	case reflect.Float32, reflect.Float64:
+		return setFloat(value.Float(), converted)
+	default:
+		number := value.Complex()
+		if converted.Kind() != reflect.Complex64 && converted.Kind() != reflect.Complex128 {
+			// only complex numbers without an imaginary part can be converted to a real number
+			if imag(number) != 0 {
+				return ErrPrecisionLoss
+			}
+
+			return setFloat(real(number), converted)
+		}
+
+		partType := reflect.TypeFor[float64]()
+		if converted.Kind() == reflect.Complex64 {
+			partType = reflect.TypeFor[float32]()
+		}
+
+		realPart, imagPart := reflect.New(partType).Elem(), reflect.New(partType).Elem()
+		if err := setFloat(real(number), realPart); err != nil {
+			return err
+		}
+
+		if err := setFloat(imag(number), imagPart); err != nil {
+			return err
+		}
+
+		converted.SetComplex(complex(realPart.Float(), imagPart.Float()))
+		return nil
+	}
+}
+
+// setFloat sets the converted value of a float. NaN and ±Inf are only converted to other float or complex types.
+func setFloat(value float64, converted reflect.Value) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		switch converted.Kind() {
+		case reflect.Float32, reflect.Float64:
+			converted.SetFloat(value)
+			return nil
+		case reflect.Complex64, reflect.Complex128:
+			converted.SetComplex(complex(value, 0))
+			return nil
+		default:
+			return ErrPrecisionLoss
+		}
+	}
+
+	return setExact(new(big.Float).SetFloat64(value), converted)
+}
+
+// setExact sets the converted value of a finite number when it is represented exactly by the converted type
+func setExact(value *big.Float, converted reflect.Value) error {
+	switch converted.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !value.IsInt() {
+			return ErrPrecisionLoss
+		}
+
+		integer, _ := value.Int(nil)
+		if !integer.IsInt64() || converted.OverflowInt(integer.Int64()) {
+			return ErrOverflow
+		}
+
+		converted.SetInt(integer.Int64())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if value.Sign() < 0 {
+			return ErrSignLoss
+		}
+
+		if !value.IsInt() {
+			return ErrPrecisionLoss
+		}
+
+		integer, _ := value.Int(nil)
+		if !integer.IsUint64() || converted.OverflowUint(integer.Uint64()) {
+			return ErrOverflow
+		}
+
+		converted.SetUint(integer.Uint64())
+	case reflect.Float32, reflect.Float64:
+		float, err := exactFloat(value, converted.Kind() == reflect.Float32)
+		if err != nil {
+			return err
+		}
+
+		converted.SetFloat(float)
+	default:
+		float, err := exactFloat(value, converted.Kind() == reflect.Complex64)
+		if err != nil {
+			return err
+		}
+
+		converted.SetComplex(complex(float, 0))
+	}
+
+	return nil
+}
+
+// exactFloat returns the float32 or float64 that exactly represents a finite number
+func exactFloat(value *big.Float, float32Bits bool) (float64, error) {
+	var float float64
+	var accuracy big.Accuracy
+	if float32Bits {
+		var float32Value float32
+		float32Value, accuracy = value.Float32()
+		float = float64(float32Value)
+	} else {
+		float, accuracy = value.Float64()
+	}
+
+	if math.IsInf(float, 0) {
+		return 0, ErrOverflow
+	}
+
+	if accuracy != big.Exact {
+		return 0, ErrPrecisionLoss
+	}
+
+	return float, nil
+}
@@ -0,0 +1,154 @@
+package gotypedjson_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	gotypedjson "github.com/DanLavine/go-typed-json"
+	. "github.com/onsi/gomega"
+)
+
+func Test_Convert(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("It returns an error for an unsupported conversion", func(t *testing.T) {
+		_, err := gotypedjson.NewTypedJson(gotypedjson.STRING, "5", nil).Convert(gotypedjson.INT64)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("failed to convert type '_string' to '_int64': unsupported conversion"))
+
+		_, err = gotypedjson.NewTypedJson(gotypedjson.INT32_SLICE, []int32{1}, nil).Convert(gotypedjson.INT64)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("failed to convert type '_int32_array' to '_int64': unsupported conversion"))
+	})
+
+	t.Run("It returns an error when the Value does not match the type", func(t *testing.T) {
+		_, err := gotypedjson.NewTypedJson(gotypedjson.INT16, int32(5), nil).Convert(gotypedjson.INT64)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("failed to cast '5' to a int16"))
+	})
+
+	t.Run("It widens values", func(t *testing.T) {
+		converted, err := gotypedjson.NewTypedJson(gotypedjson.INT16, int16(-5), nil).Convert(gotypedjson.INT64)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(converted.Type).To(Equal(gotypedjson.INT64))
+		g.Expect(converted.Value).To(Equal(int64(-5)))
+
+		converted, err = gotypedjson.NewTypedJson(gotypedjson.FLOAT32, float32(0.1), nil).Convert(gotypedjson.FLOAT64)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(converted.Value).To(Equal(float64(float32(0.1))))
+
+		converted, err = gotypedjson.NewTypedJson(gotypedjson.UINT8, uint8(200), nil).Convert(gotypedjson.COMPLEX64)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(converted.Value).To(Equal(complex64(200)))
+	})
+
+	t.Run("It narrows values that fit exactly", func(t *testing.T) {
+		converted, err := gotypedjson.NewTypedJson(gotypedjson.INT64, int64(100), nil).Convert(gotypedjson.UINT8)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(converted.Value).To(Equal(uint8(100)))
+
+		converted, err = gotypedjson.NewTypedJson(gotypedjson.FLOAT64, float64(2.5), nil).Convert(gotypedjson.FLOAT32)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(converted.Value).To(Equal(float32(2.5)))
+
+		converted, err = gotypedjson.NewTypedJson(gotypedjson.FLOAT64, float64(-3), nil).Convert(gotypedjson.INT)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(converted.Value).To(Equal(-3))
+
+		converted, err = gotypedjson.NewTypedJson(gotypedjson.COMPLEX128, complex(4, 0), nil).Convert(gotypedjson.INT32)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(converted.Value).To(Equal(int32(4)))
+	})
+
+	t.Run("It converts NaN and infinity between floats", func(t *testing.T) {
+		converted, err := gotypedjson.NewTypedJson(gotypedjson.FLOAT64, math.Inf(-1), nil).Convert(gotypedjson.FLOAT32)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(converted.Value).To(Equal(float32(math.Inf(-1))))
+
+		converted, err = gotypedjson.NewTypedJson(gotypedjson.FLOAT32, float32(math.NaN()), nil).Convert(gotypedjson.FLOAT64)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(math.IsNaN(converted.Value.(float64))).To(BeTrue())
+
+		_, err = gotypedjson.NewTypedJson(gotypedjson.FLOAT64, math.NaN(), nil).Convert(gotypedjson.INT64)
+		g.Expect(err).To(MatchError(gotypedjson.ErrPrecisionLoss))
+	})
+
+	t.Run("It returns an error for an overflow", func(t *testing.T) {
+		_, err := gotypedjson.NewTypedJson(gotypedjson.INT64, int64(300), nil).Convert(gotypedjson.INT8)
+		g.Expect(err).To(MatchError(gotypedjson.ErrOverflow))
+		g.Expect(err.Error()).To(Equal("failed to convert type '_int64' to '_int8': '300': value overflows the target type"))
+
+		_, err = gotypedjson.NewTypedJson(gotypedjson.UINT64, uint64(math.MaxUint64), nil).Convert(gotypedjson.INT64)
+		g.Expect(err).To(MatchError(gotypedjson.ErrOverflow))
+
+		_, err = gotypedjson.NewTypedJson(gotypedjson.FLOAT64, math.MaxFloat64, nil).Convert(gotypedjson.FLOAT32)
+		g.Expect(err).To(MatchError(gotypedjson.ErrOverflow))
+	})
+
+	t.Run("It returns an error for a loss of precision", func(t *testing.T) {
+		_, err := gotypedjson.NewTypedJson(gotypedjson.FLOAT64, float64(0.1), nil).Convert(gotypedjson.FLOAT32)
+		g.Expect(err).To(MatchError(gotypedjson.ErrPrecisionLoss))
+
+		_, err = gotypedjson.NewTypedJson(gotypedjson.FLOAT32, float32(1.5), nil).Convert(gotypedjson.INT64)
+		g.Expect(err).To(MatchError(gotypedjson.ErrPrecisionLoss))
+
+		_, err = gotypedjson.NewTypedJson(gotypedjson.INT64, int64(1<<53+1), nil).Convert(gotypedjson.FLOAT64)
+		g.Expect(err).To(MatchError(gotypedjson.ErrPrecisionLoss))
+
+		_, err = gotypedjson.NewTypedJson(gotypedjson.COMPLEX64, complex64(1+1i), nil).Convert(gotypedjson.FLOAT64)
+		g.Expect(err).To(MatchError(gotypedjson.ErrPrecisionLoss))
+	})
+
+	t.Run("It returns an error for a loss of sign", func(t *testing.T) {
+		_, err := gotypedjson.NewTypedJson(gotypedjson.INT, -1, nil).Convert(gotypedjson.UINT64)
+		g.Expect(err).To(MatchError(gotypedjson.ErrSignLoss))
+	})
+
+	t.Run("Slices", func(t *testing.T) {
+		t.Run("It converts each element", func(t *testing.T) {
+			converted, err := gotypedjson.NewTypedJson(gotypedjson.INT32_SLICE, []int32{1, -2}, nil).Convert(gotypedjson.INT64_SLICE)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(converted.Type).To(Equal(gotypedjson.INT64_SLICE))
+			g.Expect(converted.Value).To(Equal([]int64{1, -2}))
+
+			converted, err = gotypedjson.NewTypedJson(gotypedjson.SliceOf(gotypedjson.UINT8_SLICE), [][]uint8{{1}, nil}, nil).Convert(gotypedjson.SliceOf(gotypedjson.FLOAT64_SLICE))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(converted.Value).To(Equal([][]float64{{1}, nil}))
+		})
+
+		t.Run("It round trips the converted values", func(t *testing.T) {
+			converted, err := gotypedjson.NewTypedJson(gotypedjson.INT32_SLICE, []int32{1000, -70000}, nil).Convert(gotypedjson.INT64_SLICE)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			data, err := json.Marshal(converted)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_int64_array","Value":"1000,-70000"}`))
+
+			decoded := &gotypedjson.TypedJson{}
+			g.Expect(json.Unmarshal(data, decoded)).ToNot(HaveOccurred())
+			g.Expect(decoded.Value).To(Equal([]int64{1000, -70000}))
+		})
+
+		t.Run("It returns an error with the index that failed", func(t *testing.T) {
+			_, err := gotypedjson.NewTypedJson(gotypedjson.INT_SLICE, []int{1, -2}, nil).Convert(gotypedjson.UINT_SLICE)
+			g.Expect(err).To(MatchError(gotypedjson.ErrSignLoss))
+			g.Expect(err.Error()).To(Equal("failed to convert type '_int_array' to '_uint_array': index 1: '-2': value loses its sign in the target type"))
+		})
+
+		t.Run("It keeps nil values", func(t *testing.T) {
+			converted, err := gotypedjson.NewTypedJson(gotypedjson.FLOAT32_SLICE, nil, nil).Convert(gotypedjson.FLOAT64_SLICE)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(converted.Value).To(BeNil())
+		})
+	})
+
+	t.Run("It can encode the converted value", func(t *testing.T) {
+		converted, err := gotypedjson.NewTypedJson(gotypedjson.INT8, int8(7), nil).Convert(gotypedjson.UINT16)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		data, err := json.Marshal(converted)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(data)).To(Equal(`{"Type":"_uint16","Value":"7"}`))
+	})
+}
@@ -0,0 +1,127 @@
+package gotypedjson
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Date is a calendar date without a time of day or location, such as a birthday or billing day
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// TimeOfDay is a wall clock time without a date or location, such as a daily cutoff
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+//	PARAMETERS:
+//	* value - time to take the date from
+//
+//	RETURNS:
+//	* Date - calendar date of the time in its own location
+//
+// Returns the calendar date of a time.Time
+func DateOf(value time.Time) Date {
+	year, month, day := value.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+//	PARAMETERS:
+//	* value - date in the form of `YYYY-MM-DD`
+//
+//	RETURNS:
+//	* Date  - parsed calendar date
+//	* error - error describing why the value is not a valid date
+//
+// Parses a date in the form of `YYYY-MM-DD`
+func ParseDate(value string) (Date, error) {
+	parsed, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return Date{}, err
+	}
+
+	return DateOf(parsed), nil
+}
+
+// IsValid reports if the date exists on the calendar
+func (date Date) IsValid() bool {
+	return DateOf(date.In(time.UTC)) == date
+}
+
+// String returns the date in the form of `YYYY-MM-DD`
+func (date Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", date.Year, date.Month, date.Day)
+}
+
+// In returns the time.Time for the start of the date in the provided location
+func (date Date) In(location *time.Location) time.Time {
+	return time.Date(date.Year, date.Month, date.Day, 0, 0, 0, 0, location)
+}
+
+// At returns the time.Time for the time of day on the date in the provided location
+func (date Date) At(timeOfDay TimeOfDay, location *time.Location) time.Time {
+	return time.Date(date.Year, date.Month, date.Day, timeOfDay.Hour, timeOfDay.Minute, timeOfDay.Second, timeOfDay.Nanosecond, location)
+}
+
+//	PARAMETERS:
+//	* value - time to take the time of day from
+//
+//	RETURNS:
+//	* TimeOfDay - wall clock time of the time in its own location
+//
+// Returns the time of day of a time.Time
+func TimeOfDayOf(value time.Time) TimeOfDay {
+	return TimeOfDay{Hour: value.Hour(), Minute: value.Minute(), Second: value.Second(), Nanosecond: value.Nanosecond()}
+}
+
+//	PARAMETERS:
+//	* value - time of day in the form of `HH:MM:SS[.fraction]`
+//
+//	RETURNS:
+//	* TimeOfDay - parsed time of day
+//	* error     - error describing why the value is not a valid time of day
+//
+// Parses a 24 hour time of day with an optional fraction of up to 9 digits
+func ParseTimeOfDay(value string) (TimeOfDay, error) {
+	// time.Parse also accepts a single digit hour and a ',' before the fraction
+	if len(value) < 8 || value[2] != ':' || value[5] != ':' {
+		return TimeOfDay{}, fmt.Errorf("time of day '%s' is not in the form of HH:MM:SS[.fraction]", value)
+	}
+
+	if len(value) > 8 && (value[8] != '.' || len(value) == 9 || len(value) > 18) {
+		return TimeOfDay{}, fmt.Errorf("time of day '%s' must have a '.' followed by 1 to 9 digits of fraction", value)
+	}
+
+	parsed, err := time.Parse("15:04:05.999999999", value)
+	if err != nil {
+		return TimeOfDay{}, err
+	}
+
+	return TimeOfDayOf(parsed), nil
+}
+
+// IsValid reports if the time of day exists on a 24 hour clock
+func (timeOfDay TimeOfDay) IsValid() bool {
+	return timeOfDay.Hour >= 0 && timeOfDay.Hour < 24 &&
+		timeOfDay.Minute >= 0 && timeOfDay.Minute < 60 &&
+		timeOfDay.Second >= 0 && timeOfDay.Second < 60 &&
+		timeOfDay.Nanosecond >= 0 && timeOfDay.Nanosecond < int(time.Second)
+}
+
+// String returns the time of day in the form of `HH:MM:SS[.fraction]`, where the fraction is only included when
+// there are nanoseconds and has no trailing zeros
+func (timeOfDay TimeOfDay) String() string {
+	encoded := fmt.Sprintf("%02d:%02d:%02d", timeOfDay.Hour, timeOfDay.Minute, timeOfDay.Second)
+	if timeOfDay.Nanosecond == 0 {
+		return encoded
+	}
+
+	return encoded + "." + strings.TrimRight(fmt.Sprintf("%09d", timeOfDay.Nanosecond), "0")
+}
@@ -0,0 +1,82 @@
+package gotypedjson_test
+
+import (
+	"testing"
+	"time"
+
+	gotypedjson "github.com/DanLavine/go-typed-json"
+	. "github.com/onsi/gomega"
+)
+
+func Test_ParseDate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("It can parse a valid date", func(t *testing.T) {
+		date, err := gotypedjson.ParseDate("2024-02-29")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(date).To(Equal(gotypedjson.Date{Year: 2024, Month: time.February, Day: 29}))
+		g.Expect(date.String()).To(Equal("2024-02-29"))
+	})
+
+	t.Run("It returns an error for dates that do not exist", func(t *testing.T) {
+		_, err := gotypedjson.ParseDate("2023-02-29")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(gotypedjson.Date{Year: 2023, Month: time.February, Day: 29}.IsValid()).To(BeFalse())
+	})
+
+	t.Run("It can be created from a time", func(t *testing.T) {
+		g.Expect(gotypedjson.DateOf(time.Date(2024, 3, 10, 23, 59, 0, 0, time.UTC))).To(Equal(gotypedjson.Date{Year: 2024, Month: time.March, Day: 10}))
+	})
+
+	t.Run("It can be combined with a location", func(t *testing.T) {
+		location := time.FixedZone("test", -5*60*60)
+		date := gotypedjson.Date{Year: 2024, Month: time.March, Day: 10}
+
+		g.Expect(date.In(location)).To(Equal(time.Date(2024, 3, 10, 0, 0, 0, 0, location)))
+		g.Expect(date.At(gotypedjson.TimeOfDay{Hour: 17, Minute: 30, Nanosecond: 5}, location)).To(Equal(time.Date(2024, 3, 10, 17, 30, 0, 5, location)))
+	})
+}
+
+func Test_ParseTimeOfDay(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("It can parse a valid time of day", func(t *testing.T) {
+		timeOfDay, err := gotypedjson.ParseTimeOfDay("17:30:05")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(timeOfDay).To(Equal(gotypedjson.TimeOfDay{Hour: 17, Minute: 30, Second: 5}))
+		g.Expect(timeOfDay.String()).To(Equal("17:30:05"))
+	})
+
+	t.Run("It preserves fractions of a second", func(t *testing.T) {
+		timeOfDay, err := gotypedjson.ParseTimeOfDay("00:00:00.0125")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(timeOfDay).To(Equal(gotypedjson.TimeOfDay{Nanosecond: 12500000}))
+		g.Expect(timeOfDay.String()).To(Equal("00:00:00.0125"))
+	})
+
+	t.Run("It returns an error for times that do not exist", func(t *testing.T) {
+		_, err := gotypedjson.ParseTimeOfDay("24:00:00")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(gotypedjson.TimeOfDay{Minute: 60}.IsValid()).To(BeFalse())
+	})
+
+	t.Run("It returns an error for values that are not in the form of HH:MM:SS[.fraction]", func(t *testing.T) {
+		_, err := gotypedjson.ParseTimeOfDay("1:20:30")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("time of day '1:20:30' is not in the form of HH:MM:SS[.fraction]"))
+
+		_, err = gotypedjson.ParseTimeOfDay("10:20:30,5")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("time of day '10:20:30,5' must have a '.' followed by 1 to 9 digits of fraction"))
+
+		_, err = gotypedjson.ParseTimeOfDay("10:20:30.")
+		g.Expect(err).To(HaveOccurred())
+
+		_, err = gotypedjson.ParseTimeOfDay("10:20:30.1234567890")
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("It can be created from a time", func(t *testing.T) {
+		g.Expect(gotypedjson.TimeOfDayOf(time.Date(2024, 3, 10, 23, 59, 1, 2, time.UTC))).To(Equal(gotypedjson.TimeOfDay{Hour: 23, Minute: 59, Second: 1, Nanosecond: 2}))
+	})
+}
@@ -0,0 +1,173 @@
+package gotypedjson
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Decimal is a fixed point number made up of an unscaled integer and a scale, where the value is
+// `unscaled * 10^-scale`. Unlike a float, the scale is always preserved so "10.50" and "10.5" are different
+// representations of the same number. The zero value is a valid Decimal of 0.
+type Decimal struct {
+	unscaled *big.Int
+	scale    uint32
+}
+
+//	PARAMETERS:
+//	* unscaled - integer value of the decimal without the decimal point
+//	* scale    - number of digits after the decimal point
+//
+//	RETURNS:
+//	* Decimal - decimal representing `unscaled * 10^-scale`
+//
+// Returns a Decimal from an int64. For example NewDecimal(1050, 2) is 10.50
+func NewDecimal(unscaled int64, scale uint32) Decimal {
+	return Decimal{unscaled: big.NewInt(unscaled), scale: scale}
+}
+
+//	PARAMETERS:
+//	* unscaled - integer value of the decimal without the decimal point
+//	* scale    - number of digits after the decimal point
+//
+//	RETURNS:
+//	* Decimal - decimal representing `unscaled * 10^-scale`
+//
+// Returns a Decimal from a big.Int, which is copied so the caller can continue to use the original value
+func NewDecimalFromBigInt(unscaled *big.Int, scale uint32) Decimal {
+	if unscaled == nil {
+		return Decimal{scale: scale}
+	}
+
+	return Decimal{unscaled: new(big.Int).Set(unscaled), scale: scale}
+}
+
+//	PARAMETERS:
+//	* value - string in the form of `[+-]digits[.digits]`
+//
+//	RETURNS:
+//	* Decimal - decimal with a scale equal to the number of digits after the decimal point
+//	* error   - error describing why the value is not a valid decimal
+//
+// Parses a decimal string, preserving any trailing zeros as part of the scale
+func ParseDecimal(value string) (Decimal, error) {
+	digits := value
+	if strings.HasPrefix(digits, "-") || strings.HasPrefix(digits, "+") {
+		digits = digits[1:]
+	}
+
+	integer, fraction, hasPoint := strings.Cut(digits, ".")
+	if integer == "" && fraction == "" {
+		return Decimal{}, fmt.Errorf("'%s' is missing digits", value)
+	}
+
+	for index, char := range integer + fraction {
+		if char < '0' || char > '9' {
+			// report the index of the original string for easier debugging
+			offset := len(value) - len(digits)
+			if hasPoint && index >= len(integer) {
+				offset++
+			}
+
+			return Decimal{}, fmt.Errorf("unexpected character '%c' at index %d", char, index+offset)
+		}
+	}
+
+	unscaled, _ := new(big.Int).SetString(integer+fraction, 10)
+	if strings.HasPrefix(value, "-") {
+		unscaled.Neg(unscaled)
+	}
+
+	return Decimal{unscaled: unscaled, scale: uint32(len(fraction))}, nil
+}
+
+// Unscaled returns a copy of the integer value of the decimal without the decimal point
+func (decimal Decimal) Unscaled() *big.Int {
+	if decimal.unscaled == nil {
+		return new(big.Int)
+	}
+
+	return new(big.Int).Set(decimal.unscaled)
+}
+
+// Scale returns the number of digits after the decimal point
+func (decimal Decimal) Scale() uint32 {
+	return decimal.scale
+}
+
+// Sign returns -1 if the decimal is negative, 0 if it is zero, and +1 if it is positive
+func (decimal Decimal) Sign() int {
+	if decimal.unscaled == nil {
+		return 0
+	}
+
+	return decimal.unscaled.Sign()
+}
+
+// String returns the decimal with exactly Scale() digits after the decimal point
+func (decimal Decimal) String() string {
+	digits := decimal.Unscaled()
+	sign := ""
+	if digits.Sign() < 0 {
+		sign = "-"
+		digits.Neg(digits)
+	}
+
+	text := digits.String()
+	if decimal.scale == 0 {
+		return sign + text
+	}
+
+	if len(text) <= int(decimal.scale) {
+		text = strings.Repeat("0", int(decimal.scale)-len(text)+1) + text
+	}
+
+	point := len(text) - int(decimal.scale)
+	return sign + text[:point] + "." + text[point:]
+}
+
+// Rat returns the exact value of the decimal as a big.Rat
+func (decimal Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(decimal.Unscaled(), pow10(decimal.scale))
+}
+
+// Cmp compares the numeric values of two decimals regardless of their scale, so 10.50 and 10.5 are equal.
+// It returns -1 if decimal < other, 0 if decimal == other, and +1 if decimal > other
+func (decimal Decimal) Cmp(other Decimal) int {
+	scale := max(decimal.scale, other.scale)
+	return decimal.rescale(scale).Cmp(other.rescale(scale))
+}
+
+// Add returns decimal + other with the larger scale of the two values
+func (decimal Decimal) Add(other Decimal) Decimal {
+	scale := max(decimal.scale, other.scale)
+	unscaled := decimal.rescale(scale)
+	return Decimal{unscaled: unscaled.Add(unscaled, other.rescale(scale)), scale: scale}
+}
+
+// Sub returns decimal - other with the larger scale of the two values
+func (decimal Decimal) Sub(other Decimal) Decimal {
+	scale := max(decimal.scale, other.scale)
+	unscaled := decimal.rescale(scale)
+	return Decimal{unscaled: unscaled.Sub(unscaled, other.rescale(scale)), scale: scale}
+}
+
+// Mul returns decimal * other with a scale that is the sum of both scales
+func (decimal Decimal) Mul(other Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(decimal.Unscaled(), other.Unscaled()), scale: decimal.scale + other.scale}
+}
+
+// Neg returns -decimal with the same scale
+func (decimal Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(decimal.Unscaled()), scale: decimal.scale}
+}
+
+// rescale returns the unscaled value of the decimal as if it had the larger scale
+func (decimal Decimal) rescale(scale uint32) *big.Int {
+	return new(big.Int).Mul(decimal.Unscaled(), pow10(scale-decimal.scale))
+}
+
+// pow10 returns 10^exponent
+func pow10(exponent uint32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
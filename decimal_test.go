@@ -0,0 +1,85 @@
+package gotypedjson_test
+
+import (
+	"math/big"
+	"testing"
+
+	gotypedjson "github.com/DanLavine/go-typed-json"
+	. "github.com/onsi/gomega"
+)
+
+func Test_ParseDecimal(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("It preserves the scale of the value", func(t *testing.T) {
+		for value, scale := range map[string]uint32{"10.50": 2, "-0.001": 3, "+7": 0, "5.": 0, ".5": 1} {
+			decimal, err := gotypedjson.ParseDecimal(value)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(decimal.Scale()).To(Equal(scale))
+		}
+
+		decimal, err := gotypedjson.ParseDecimal("10.50")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(decimal.String()).To(Equal("10.50"))
+		g.Expect(decimal.Unscaled()).To(Equal(big.NewInt(1050)))
+	})
+
+	t.Run("It returns an error for values that are missing digits", func(t *testing.T) {
+		for _, value := range []string{"", "-", "."} {
+			_, err := gotypedjson.ParseDecimal(value)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("'" + value + "' is missing digits"))
+		}
+	})
+
+	t.Run("It returns an error for unexpected characters", func(t *testing.T) {
+		_, err := gotypedjson.ParseDecimal("-12.3x")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("unexpected character 'x' at index 5"))
+
+		_, err = gotypedjson.ParseDecimal("1.2.3")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("unexpected character '.' at index 3"))
+
+		_, err = gotypedjson.ParseDecimal("1e5")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("unexpected character 'e' at index 1"))
+	})
+}
+
+func Test_Decimal_String(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(gotypedjson.Decimal{}.String()).To(Equal("0"))
+	g.Expect(gotypedjson.NewDecimal(1050, 2).String()).To(Equal("10.50"))
+	g.Expect(gotypedjson.NewDecimal(-5, 3).String()).To(Equal("-0.005"))
+	g.Expect(gotypedjson.NewDecimal(0, 2).String()).To(Equal("0.00"))
+	g.Expect(gotypedjson.NewDecimalFromBigInt(big.NewInt(42), 0).String()).To(Equal("42"))
+}
+
+func Test_Decimal_Cmp(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(gotypedjson.NewDecimal(1050, 2).Cmp(gotypedjson.NewDecimal(105, 1))).To(Equal(0))
+	g.Expect(gotypedjson.NewDecimal(1050, 2).Cmp(gotypedjson.NewDecimal(1051, 2))).To(Equal(-1))
+	g.Expect(gotypedjson.NewDecimal(2, 0).Cmp(gotypedjson.NewDecimal(1999, 3))).To(Equal(1))
+	g.Expect(gotypedjson.Decimal{}.Cmp(gotypedjson.NewDecimal(0, 4))).To(Equal(0))
+}
+
+func Test_Decimal_Arithmetic(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	a := gotypedjson.NewDecimal(1050, 2)
+	b := gotypedjson.NewDecimal(25, 1)
+
+	g.Expect(a.Add(b).String()).To(Equal("13.00"))
+	g.Expect(a.Sub(b).String()).To(Equal("8.00"))
+	g.Expect(a.Mul(b).String()).To(Equal("26.250"))
+	g.Expect(a.Neg().String()).To(Equal("-10.50"))
+	g.Expect(a.Rat()).To(Equal(big.NewRat(21, 2)))
+	g.Expect(a.Neg().Sign()).To(Equal(-1))
+
+	// the original values are never modified
+	g.Expect(a.String()).To(Equal("10.50"))
+	g.Expect(b.String()).To(Equal("2.5"))
+}
@@ -0,0 +1,82 @@
+package gotypedjson
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// EnumMember are the Go types that can back an enum created with NewEnumCodec
+type EnumMember interface {
+	~string | ~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+//	PARAMETERS:
+//	* members - every value that is allowed for the enum
+//
+//	RETURNS:
+//	* Codec - codec that can be set on a custom JSONTYPE of a CustomCodec or the GlobalCodec
+//
+// Returns a codec for an enum type that only encodes and decodes the provided members. String backed enums are
+// encoded as their underlying string and integer backed enums as their base 10 value, ignoring any String() method
+// on the type. Decoding always returns a value of type T. This will panic if no members are provided.
+func NewEnumCodec[T EnumMember](members ...T) Codec {
+	if len(members) == 0 {
+		panic("enum must have at least one member")
+	}
+
+	typeName := reflect.TypeFor[T]().String()
+
+	allowed := make(map[string]T, len(members))
+	names := make([]string, 0, len(members))
+	for _, member := range members {
+		encoded := formatEnum(member)
+		if _, ok := allowed[encoded]; ok {
+			continue
+		}
+
+		allowed[encoded] = member
+		names = append(names, encoded)
+	}
+	allowedNames := strings.Join(names, ", ")
+
+	return Codec{
+		Encode: func(val any) (string, error) {
+			value, ok := val.(T)
+			if !ok {
+				return "", fmt.Errorf("failed to cast '%v' to a %s", val, typeName)
+			}
+
+			encoded := formatEnum(value)
+			if _, ok := allowed[encoded]; !ok {
+				return "", fmt.Errorf("failed to cast '%s' to a %s: allowed values are [%s]", encoded, typeName, allowedNames)
+			}
+
+			return encoded, nil
+		},
+		Decode: func(s string) (any, error) {
+			value, ok := allowed[s]
+			if !ok {
+				return nil, fmt.Errorf("failed to convert '%s' to a %s: allowed values are [%s]", s, typeName, allowedNames)
+			}
+
+			return value, nil
+		},
+		GoTypes: []reflect.Type{reflect.TypeFor[T]()},
+	}
+}
+
+// formatEnum encodes the underlying value of an enum member
+func formatEnum[T EnumMember](member T) string {
+	value := reflect.ValueOf(member)
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	default:
+		return strconv.FormatUint(value.Uint(), 10)
+	}
+}
@@ -0,0 +1,101 @@
+package gotypedjson_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	gotypedjson "github.com/DanLavine/go-typed-json"
+	. "github.com/onsi/gomega"
+)
+
+type testStatus string
+
+const (
+	testStatusActive   testStatus = "active"
+	testStatusInactive testStatus = "inactive"
+)
+
+type testTier uint8
+
+const (
+	testTierFree testTier = iota
+	testTierPaid
+)
+
+// String is ignored when encoding the enum
+func (tier testTier) String() string {
+	return [...]string{"free", "paid"}[tier]
+}
+
+func Test_NewEnumCodec(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	codec := gotypedjson.CustomCodec{
+		"status": gotypedjson.NewEnumCodec(testStatusActive, testStatusInactive),
+		"tier":   gotypedjson.NewEnumCodec(testTierFree, testTierPaid),
+	}
+
+	t.Run("It panics without any members", func(t *testing.T) {
+		g.Expect(func() { gotypedjson.NewEnumCodec[testStatus]() }).To(Panic())
+	})
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tEnum := gotypedjson.NewTypedJson("status", "active", codec)
+
+			_, err := tEnum.MarshalJSON()
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to cast 'active' to a gotypedjson_test.testStatus"))
+		})
+
+		t.Run("It returns an error for an unknown member", func(t *testing.T) {
+			tEnum := gotypedjson.NewTypedJson("status", testStatus("deleted"), codec)
+
+			_, err := tEnum.MarshalJSON()
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to cast 'deleted' to a gotypedjson_test.testStatus: allowed values are [active, inactive]"))
+		})
+
+		t.Run("It can encode a string member", func(t *testing.T) {
+			tEnum := gotypedjson.NewTypedJson("status", testStatusInactive, codec)
+
+			data, err := tEnum.MarshalJSON()
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"status","Value":"inactive"}`))
+		})
+
+		t.Run("It can encode an integer member", func(t *testing.T) {
+			tEnum := gotypedjson.NewTypedJson("tier", testTierPaid, codec)
+
+			data, err := tEnum.MarshalJSON()
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"tier","Value":"1"}`))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It returns an error listing the allowed members", func(t *testing.T) {
+			tEnum := gotypedjson.NewTypedJsonDecoder(codec)
+
+			err := json.Unmarshal([]byte(`{"Type":"tier","Value":"2"}`), tEnum)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert '2' to a gotypedjson_test.testTier: allowed values are [0, 1]"))
+		})
+
+		t.Run("It can decode a string member", func(t *testing.T) {
+			tEnum := gotypedjson.NewTypedJsonDecoder(codec)
+
+			err := json.Unmarshal([]byte(`{"Type":"status","Value":"active"}`), tEnum)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tEnum.Value).To(Equal(testStatusActive))
+		})
+
+		t.Run("It can decode an integer member", func(t *testing.T) {
+			tEnum := gotypedjson.NewTypedJsonDecoder(codec)
+
+			err := json.Unmarshal([]byte(`{"Type":"tier","Value":"0"}`), tEnum)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tEnum.Value).To(Equal(testTierFree))
+		})
+	})
+}
@@ -0,0 +1,121 @@
+package gotypedjson
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"strconv"
+)
+
+// FLOATPOLICY defines how NaN and ±Inf are encoded and decoded for all float and complex types
+type FLOATPOLICY int
+
+const (
+	// FLOAT_ALLOW encodes NaN and ±Inf as the strings "NaN", "+Inf" and "-Inf". This is the default policy
+	FLOAT_ALLOW FLOATPOLICY = iota
+
+	// FLOAT_REJECT returns an error when encoding or decoding NaN or ±Inf
+	FLOAT_REJECT
+
+	// FLOAT_NULL encodes NaN and ±Inf as null and decodes them back to a nil Value. Elements of slices and maps
+	// can not be null, so they are encoded as the string "null" instead which decodes to NaN. The string "null" is
+	// only accepted with this policy
+	FLOAT_NULL
+)
+
+// GlobalFloatPolicy is the policy for NaN and ±Inf used by all TypedJson structs when encoding and decoding the
+// FLOAT32, FLOAT64, COMPLEX64 and COMPLEX128 types, along with their slice and map types.
+var GlobalFloatPolicy FLOATPOLICY = FLOAT_ALLOW
+
+// nullFloat is used for NaN and ±Inf inside of slices and maps when using the FLOAT_NULL policy
+const nullFloat = "null"
+
+// isNonFinite reports if the Value of a scalar float or complex type is NaN or ±Inf
+func (typedJson *TypedJson) isNonFinite() bool {
+	switch typedJson.Type {
+	case FLOAT32, FLOAT64, COMPLEX64, COMPLEX128:
+		switch value := typedJson.Value.(type) {
+		case float32:
+			return !isFinite(float64(value))
+		case float64:
+			return !isFinite(value)
+		case complex64:
+			return !isFinite(float64(real(value))) || !isFinite(float64(imag(value)))
+		case complex128:
+			return !isFinite(real(value)) || !isFinite(imag(value))
+		}
+	}
+
+	return false
+}
+
+// formatFloat encodes a float in the same way as strconv.FormatFloat while applying the GlobalFloatPolicy
+func formatFloat(value float64, bitSize int, name string) (string, error) {
+	if !isFinite(value) {
+		switch GlobalFloatPolicy {
+		case FLOAT_REJECT:
+			return "", fmt.Errorf("failed to cast '%v' to a %s: NaN and infinite values are rejected", value, name)
+		case FLOAT_NULL:
+			return nullFloat, nil
+		}
+	}
+
+	return strconv.FormatFloat(value, 'E', -1, bitSize), nil
+}
+
+// formatComplex encodes a complex number in the same way as strconv.FormatComplex while applying the
+// GlobalFloatPolicy when either part is NaN or ±Inf
+func formatComplex(value complex128, bitSize int, name string) (string, error) {
+	if !isFinite(real(value)) || !isFinite(imag(value)) {
+		switch GlobalFloatPolicy {
+		case FLOAT_REJECT:
+			return "", fmt.Errorf("failed to cast '%v' to a %s: NaN and infinite values are rejected", value, name)
+		case FLOAT_NULL:
+			return nullFloat, nil
+		}
+	}
+
+	return strconv.FormatComplex(value, 'E', -1, bitSize), nil
+}
+
+// parseFloat decodes a float in the same way as strconv.ParseFloat while applying the GlobalFloatPolicy
+func parseFloat(encoded string, bitSize int, name string) (float64, error) {
+	if GlobalFloatPolicy == FLOAT_NULL && encoded == nullFloat {
+		return math.NaN(), nil
+	}
+
+	value, err := strconv.ParseFloat(encoded, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert '%s' to a %s", encoded, name)
+	}
+
+	if GlobalFloatPolicy == FLOAT_REJECT && !isFinite(value) {
+		return 0, fmt.Errorf("failed to convert '%s' to a %s: NaN and infinite values are rejected", encoded, name)
+	}
+
+	return value, nil
+}
+
+// parseComplex decodes a complex number in the same way as strconv.ParseComplex while applying the
+// GlobalFloatPolicy
+func parseComplex(encoded string, bitSize int, name string) (complex128, error) {
+	if GlobalFloatPolicy == FLOAT_NULL && encoded == nullFloat {
+		return cmplx.NaN(), nil
+	}
+
+	value, err := strconv.ParseComplex(encoded, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert '%s' to a %s", encoded, name)
+	}
+
+	if GlobalFloatPolicy == FLOAT_REJECT && (!isFinite(real(value)) || !isFinite(imag(value))) {
+		return 0, fmt.Errorf("failed to convert '%s' to a %s: NaN and infinite values are rejected", encoded, name)
+	}
+
+	return value, nil
+}
+
+// isFinite reports if the value is neither NaN nor ±Inf
+func isFinite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
@@ -0,0 +1,136 @@
+package gotypedjson_test
+
+import (
+	"encoding/json"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	gotypedjson "github.com/DanLavine/go-typed-json"
+	. "github.com/onsi/gomega"
+)
+
+func Test_FloatPolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("Describe when NaN and infinite values are allowed", func(t *testing.T) {
+		t.Run("It encodes and decodes the special values as strings", func(t *testing.T) {
+			data, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.FLOAT64_SLICE, Value: []float64{math.Inf(1), math.Inf(-1), 1}})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_float64_array","Value":"+Inf,-Inf,1E+00"}`))
+
+			tFloat := &gotypedjson.TypedJson{}
+			g.Expect(json.Unmarshal([]byte(`{"Type":"_float32","Value":"NaN"}`), tFloat)).ToNot(HaveOccurred())
+			g.Expect(math.IsNaN(float64(tFloat.Value.(float32)))).To(BeTrue())
+		})
+
+		t.Run("It returns an error when decoding the string null", func(t *testing.T) {
+			tFloat := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_float64","Value":"null"}`), tFloat)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert 'null' to a float64"))
+
+			err = json.Unmarshal([]byte(`{"Type":"_complex128_array","Value":"null"}`), tFloat)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert 'null' to a complex128"))
+		})
+	})
+
+	t.Run("Describe when NaN and infinite values are rejected", func(t *testing.T) {
+		gotypedjson.GlobalFloatPolicy = gotypedjson.FLOAT_REJECT
+		defer func() {
+			gotypedjson.GlobalFloatPolicy = gotypedjson.FLOAT_ALLOW
+		}()
+
+		t.Run("It returns an error when encoding a scalar", func(t *testing.T) {
+			_, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.FLOAT64, Value: math.NaN()})
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'NaN' to a float64: NaN and infinite values are rejected"))
+		})
+
+		t.Run("It returns an error when encoding a slice element", func(t *testing.T) {
+			_, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.FLOAT32_SLICE, Value: []float32{1, float32(math.Inf(1))}})
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast '+Inf' to a float32: NaN and infinite values are rejected"))
+		})
+
+		t.Run("It returns an error when encoding a complex number", func(t *testing.T) {
+			_, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.COMPLEX128, Value: complex(1, math.Inf(-1))})
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast '(1-Infi)' to a complex128: NaN and infinite values are rejected"))
+		})
+
+		t.Run("It returns an error when encoding a map value", func(t *testing.T) {
+			_, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.STRING_FLOAT64_MAP, Value: map[string]float64{"a": math.NaN()}})
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to encode key 'a': failed to cast 'NaN' to a float64: NaN and infinite values are rejected"))
+		})
+
+		t.Run("It returns an error when decoding the special values", func(t *testing.T) {
+			tFloat := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_float64","Value":"+Inf"}`), tFloat)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert '+Inf' to a float64: NaN and infinite values are rejected"))
+
+			err = json.Unmarshal([]byte(`{"Type":"_complex64_array","Value":"1E+00+0E+00i,null"}`), tFloat)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert 'null' to a complex64"))
+		})
+
+		t.Run("It can still encode and decode finite values", func(t *testing.T) {
+			data, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.FLOAT64, Value: 1.5})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			tFloat := &gotypedjson.TypedJson{}
+			g.Expect(json.Unmarshal(data, tFloat)).ToNot(HaveOccurred())
+			g.Expect(tFloat.Value).To(Equal(1.5))
+		})
+	})
+
+	t.Run("Describe when NaN and infinite values are mapped to null", func(t *testing.T) {
+		gotypedjson.GlobalFloatPolicy = gotypedjson.FLOAT_NULL
+		defer func() {
+			gotypedjson.GlobalFloatPolicy = gotypedjson.FLOAT_ALLOW
+		}()
+
+		t.Run("It encodes scalars as null", func(t *testing.T) {
+			data, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.FLOAT32, Value: float32(math.NaN())})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_float32","Value":null}`))
+
+			data, err = json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.COMPLEX64, Value: complex64(cmplx.Inf())})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_complex64","Value":null}`))
+		})
+
+		t.Run("It encodes slice and map elements as the string null", func(t *testing.T) {
+			data, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.FLOAT64_SLICE, Value: []float64{1, math.NaN()}})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_float64_array","Value":"1E+00,null"}`))
+
+			data, err = json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.STRING_FLOAT32_MAP, Value: map[string]float32{"a": float32(math.Inf(-1))}})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_string_float32_map","Value":{"a":"null"}}`))
+		})
+
+		t.Run("It decodes scalars as nil", func(t *testing.T) {
+			tFloat := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_float64","Value":"-Inf"}`), tFloat)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tFloat.Type).To(Equal(gotypedjson.FLOAT64))
+			g.Expect(tFloat.Value).To(BeNil())
+		})
+
+		t.Run("It decodes slice elements as NaN", func(t *testing.T) {
+			tFloat := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_complex128_array","Value":"null,1E+00+2E+00i"}`), tFloat)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(cmplx.IsNaN(tFloat.Value.([]complex128)[0])).To(BeTrue())
+			g.Expect(tFloat.Value.([]complex128)[1]).To(Equal(complex(1, 2)))
+		})
+	})
+}
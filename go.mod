@@ -2,9 +2,10 @@ module github.com/DanLavine/go-typed-json
 
 go 1.23.2
 
+require github.com/onsi/gomega v1.35.1
+
 require (
 	github.com/google/go-cmp v0.6.0 // indirect
-	github.com/onsi/gomega v1.35.1 // indirect
 	golang.org/x/net v0.30.0 // indirect
 	golang.org/x/text v0.19.0 // indirect
 	google.golang.org/protobuf v1.35.1 // indirect
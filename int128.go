@@ -0,0 +1,199 @@
+package gotypedjson
+
+import (
+	"cmp"
+	"fmt"
+	"math/big"
+)
+
+// Int128 is a signed 128 bit integer stored in two's complement as the high and low 64 bits
+type Int128 struct {
+	Hi int64
+	Lo uint64
+}
+
+// Uint128 is an unsigned 128 bit integer stored as the high and low 64 bits
+type Uint128 struct {
+	Hi uint64
+	Lo uint64
+}
+
+var (
+	// MinInt128 is the smallest value an Int128 can hold, -2^127
+	MinInt128 = Int128{Hi: -1 << 63}
+
+	// MaxInt128 is the largest value an Int128 can hold, 2^127 - 1
+	MaxInt128 = Int128{Hi: 1<<63 - 1, Lo: 1<<64 - 1}
+
+	// MaxUint128 is the largest value a Uint128 can hold, 2^128 - 1
+	MaxUint128 = Uint128{Hi: 1<<64 - 1, Lo: 1<<64 - 1}
+)
+
+//	PARAMETERS:
+//	* value - 64 bit integer to widen
+//
+//	RETURNS:
+//	* Int128 - 128 bit integer with the same value
+//
+// Returns an Int128 from an int64, extending the sign into the high bits
+func Int128From64(value int64) Int128 {
+	return Int128{Hi: value >> 63, Lo: uint64(value)}
+}
+
+//	PARAMETERS:
+//	* value - base 10 string in the form of `[+-]digits`
+//
+//	RETURNS:
+//	* Int128 - parsed 128 bit integer
+//	* error  - error describing why the value is not a valid int128
+//
+// Parses a base 10 string into an Int128, returning an error if the value is outside of [MinInt128, MaxInt128]
+func ParseInt128(value string) (Int128, error) {
+	parsed, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return Int128{}, fmt.Errorf("'%s' is not a base 10 integer", value)
+	}
+
+	return Int128FromBigInt(parsed)
+}
+
+//	PARAMETERS:
+//	* value - integer to convert
+//
+//	RETURNS:
+//	* Int128 - 128 bit integer with the same value
+//	* error  - error if the value is outside of [MinInt128, MaxInt128]
+//
+// Returns an Int128 from a big.Int
+func Int128FromBigInt(value *big.Int) (Int128, error) {
+	if value.Cmp(MinInt128.BigInt()) < 0 || value.Cmp(MaxInt128.BigInt()) > 0 {
+		return Int128{}, fmt.Errorf("'%s' overflows an int128", value)
+	}
+
+	bits := new(big.Int).Set(value)
+	if bits.Sign() < 0 {
+		bits.Add(bits, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+
+	hi, lo := split128(bits)
+	return Int128{Hi: int64(hi), Lo: lo}, nil
+}
+
+// BigInt returns the value as a big.Int
+func (value Int128) BigInt() *big.Int {
+	bits := join128(uint64(value.Hi), value.Lo)
+	if value.Hi < 0 {
+		bits.Sub(bits, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+
+	return bits
+}
+
+// String returns the base 10 representation of the value
+func (value Int128) String() string {
+	return value.BigInt().String()
+}
+
+// Sign returns -1 if the value is negative, 0 if it is zero, and +1 if it is positive
+func (value Int128) Sign() int {
+	switch {
+	case value.Hi < 0:
+		return -1
+	case value.Hi == 0 && value.Lo == 0:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Cmp returns -1 if value < other, 0 if value == other, and +1 if value > other
+func (value Int128) Cmp(other Int128) int {
+	switch {
+	case value.Hi < other.Hi:
+		return -1
+	case value.Hi > other.Hi:
+		return 1
+	default:
+		return cmp.Compare(value.Lo, other.Lo)
+	}
+}
+
+//	PARAMETERS:
+//	* value - 64 bit integer to widen
+//
+//	RETURNS:
+//	* Uint128 - 128 bit integer with the same value
+//
+// Returns a Uint128 from a uint64
+func Uint128From64(value uint64) Uint128 {
+	return Uint128{Lo: value}
+}
+
+//	PARAMETERS:
+//	* value - base 10 string in the form of `[+]digits`
+//
+//	RETURNS:
+//	* Uint128 - parsed 128 bit integer
+//	* error   - error describing why the value is not a valid uint128
+//
+// Parses a base 10 string into a Uint128, returning an error if the value is negative or larger than MaxUint128
+func ParseUint128(value string) (Uint128, error) {
+	parsed, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return Uint128{}, fmt.Errorf("'%s' is not a base 10 integer", value)
+	}
+
+	return Uint128FromBigInt(parsed)
+}
+
+//	PARAMETERS:
+//	* value - integer to convert
+//
+//	RETURNS:
+//	* Uint128 - 128 bit integer with the same value
+//	* error   - error if the value is negative or larger than MaxUint128
+//
+// Returns a Uint128 from a big.Int
+func Uint128FromBigInt(value *big.Int) (Uint128, error) {
+	if value.Sign() < 0 || value.BitLen() > 128 {
+		return Uint128{}, fmt.Errorf("'%s' overflows a uint128", value)
+	}
+
+	hi, lo := split128(value)
+	return Uint128{Hi: hi, Lo: lo}, nil
+}
+
+// BigInt returns the value as a big.Int
+func (value Uint128) BigInt() *big.Int {
+	return join128(value.Hi, value.Lo)
+}
+
+// String returns the base 10 representation of the value
+func (value Uint128) String() string {
+	return value.BigInt().String()
+}
+
+// Cmp returns -1 if value < other, 0 if value == other, and +1 if value > other
+func (value Uint128) Cmp(other Uint128) int {
+	if value.Hi != other.Hi {
+		return cmp.Compare(value.Hi, other.Hi)
+	}
+
+	return cmp.Compare(value.Lo, other.Lo)
+}
+
+// split128 returns the high and low 64 bits of a non negative value that fits in 128 bits
+func split128(value *big.Int) (uint64, uint64) {
+	lo := new(big.Int).And(value, new(big.Int).SetUint64(1<<64-1)).Uint64()
+	hi := new(big.Int).Rsh(value, 64).Uint64()
+
+	return hi, lo
+}
+
+// join128 returns the non negative value made up of the high and low 64 bits
+func join128(hi, lo uint64) *big.Int {
+	value := new(big.Int).SetUint64(hi)
+	value.Lsh(value, 64)
+
+	return value.Or(value, new(big.Int).SetUint64(lo))
+}
@@ -0,0 +1,105 @@
+package gotypedjson_test
+
+import (
+	"math/big"
+	"testing"
+
+	gotypedjson "github.com/DanLavine/go-typed-json"
+	. "github.com/onsi/gomega"
+)
+
+func Test_ParseInt128(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("It can parse the full range of values", func(t *testing.T) {
+		value, err := gotypedjson.ParseInt128("-170141183460469231731687303715884105728")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(value).To(Equal(gotypedjson.MinInt128))
+
+		value, err = gotypedjson.ParseInt128("170141183460469231731687303715884105727")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(value).To(Equal(gotypedjson.MaxInt128))
+
+		value, err = gotypedjson.ParseInt128("-1")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(value).To(Equal(gotypedjson.Int128From64(-1)))
+		g.Expect(value.String()).To(Equal("-1"))
+	})
+
+	t.Run("It returns an error on overflow", func(t *testing.T) {
+		_, err := gotypedjson.ParseInt128("170141183460469231731687303715884105728")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("'170141183460469231731687303715884105728' overflows an int128"))
+
+		_, err = gotypedjson.ParseInt128("-170141183460469231731687303715884105729")
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("It returns an error for a non integer", func(t *testing.T) {
+		_, err := gotypedjson.ParseInt128("1.5")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("'1.5' is not a base 10 integer"))
+	})
+}
+
+func Test_ParseUint128(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("It can parse the full range of values", func(t *testing.T) {
+		value, err := gotypedjson.ParseUint128("0")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(value).To(Equal(gotypedjson.Uint128{}))
+
+		value, err = gotypedjson.ParseUint128("340282366920938463463374607431768211455")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(value).To(Equal(gotypedjson.MaxUint128))
+		g.Expect(value.String()).To(Equal("340282366920938463463374607431768211455"))
+	})
+
+	t.Run("It returns an error on overflow", func(t *testing.T) {
+		_, err := gotypedjson.ParseUint128("340282366920938463463374607431768211456")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("'340282366920938463463374607431768211456' overflows a uint128"))
+
+		_, err = gotypedjson.ParseUint128("-1")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("'-1' overflows a uint128"))
+	})
+}
+
+func Test_Int128_Cmp(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(gotypedjson.Int128From64(-1).Cmp(gotypedjson.Int128From64(1))).To(Equal(-1))
+	g.Expect(gotypedjson.MaxInt128.Cmp(gotypedjson.MinInt128)).To(Equal(1))
+	g.Expect(gotypedjson.Int128From64(-5).Cmp(gotypedjson.Int128From64(-6))).To(Equal(1))
+	g.Expect(gotypedjson.Int128From64(7).Cmp(gotypedjson.Int128{Lo: 7})).To(Equal(0))
+
+	g.Expect(gotypedjson.Int128From64(-5).Sign()).To(Equal(-1))
+	g.Expect(gotypedjson.Int128{}.Sign()).To(Equal(0))
+	g.Expect(gotypedjson.Int128{Hi: 1}.Sign()).To(Equal(1))
+}
+
+func Test_Uint128_Cmp(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(gotypedjson.Uint128From64(1).Cmp(gotypedjson.Uint128{Hi: 1})).To(Equal(-1))
+	g.Expect(gotypedjson.MaxUint128.Cmp(gotypedjson.Uint128From64(1<<64 - 1))).To(Equal(1))
+	g.Expect(gotypedjson.Uint128From64(3).Cmp(gotypedjson.Uint128{Lo: 3})).To(Equal(0))
+}
+
+func Test_Int128_BigInt(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	value, ok := new(big.Int).SetString("-18446744073709551617", 10)
+	g.Expect(ok).To(BeTrue())
+
+	int128, err := gotypedjson.Int128FromBigInt(value)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(int128).To(Equal(gotypedjson.Int128{Hi: -2, Lo: 1<<64 - 1}))
+	g.Expect(int128.BigInt().Cmp(value)).To(Equal(0))
+
+	uint128, err := gotypedjson.Uint128FromBigInt(new(big.Int).Lsh(big.NewInt(1), 64))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(uint128).To(Equal(gotypedjson.Uint128{Hi: 1}))
+}
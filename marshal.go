@@ -0,0 +1,463 @@
+package gotypedjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// structField describes a struct field with a `typedjson` tag
+type structField struct {
+	// index of the field in the struct
+	index int
+	// name is the key the field is encoded under
+	name string
+	// jsonType used to encode and decode the field
+	jsonType JSONTYPE
+	// omitEmpty skips encoding the zero value, or an empty slice or map
+	omitEmpty bool
+	// pointer is true when the field is a pointer to the encoded value. A nil pointer is encoded as null
+	pointer bool
+	// nested is true when the field is a struct that is encoded as an OBJECT of its own tagged fields
+	nested bool
+	// goType is the Go type of the Value for the jsonType, which the field is converted to and from
+	goType reflect.Type
+}
+
+// basicTypes are the unnamed Go types for each basic kind, used to convert named types such as `type Status string`
+var basicTypes = map[reflect.Kind]reflect.Type{
+	reflect.Bool:       reflect.TypeFor[bool](),
+	reflect.Int:        reflect.TypeFor[int](),
+	reflect.Int8:       reflect.TypeFor[int8](),
+	reflect.Int16:      reflect.TypeFor[int16](),
+	reflect.Int32:      reflect.TypeFor[int32](),
+	reflect.Int64:      reflect.TypeFor[int64](),
+	reflect.Uint:       reflect.TypeFor[uint](),
+	reflect.Uint8:      reflect.TypeFor[uint8](),
+	reflect.Uint16:     reflect.TypeFor[uint16](),
+	reflect.Uint32:     reflect.TypeFor[uint32](),
+	reflect.Uint64:     reflect.TypeFor[uint64](),
+	reflect.Float32:    reflect.TypeFor[float32](),
+	reflect.Float64:    reflect.TypeFor[float64](),
+	reflect.Complex64:  reflect.TypeFor[complex64](),
+	reflect.Complex128: reflect.TypeFor[complex128](),
+	reflect.String:     reflect.TypeFor[string](),
+}
+
+// underlyingType returns the unnamed Go type a named type can be converted to. Arrays are replaced with slices.
+func underlyingType(goType reflect.Type) reflect.Type {
+	if basicType, ok := basicTypes[goType.Kind()]; ok {
+		return basicType
+	}
+
+	switch goType.Kind() {
+	case reflect.Slice, reflect.Array:
+		return reflect.SliceOf(underlyingType(goType.Elem()))
+	case reflect.Map:
+		return reflect.MapOf(underlyingType(goType.Key()), underlyingType(goType.Elem()))
+	default:
+		return goType
+	}
+}
+
+// goTypeOf returns the Go type of the Value for one of the default types. The boolean is false for custom types.
+func goTypeOf(jsonType JSONTYPE) (reflect.Type, bool) {
+	if goType, ok := scalarTypes[jsonType]; ok {
+		return goType, true
+	}
+
+	if goType, ok := sliceTypes[jsonType]; ok {
+		return goType, true
+	}
+
+	if stringMap, ok := stringMaps[jsonType]; ok {
+		return stringMap.goType, true
+	}
+
+	if _, ok := bytesEncodings[jsonType]; ok {
+		return reflect.TypeFor[[]byte](), true
+	}
+
+	if _, goType, ok := nestedSlice(jsonType); ok {
+		return goType, true
+	}
+
+	return nil, false
+}
+
+// inferField returns the type and Go type of the Value for a field's Go type. Named types are inferred from the
+// type they can be converted to, such as STRING for `type Status string`.
+func inferField(goType reflect.Type) (JSONTYPE, reflect.Type, error) {
+	jsonType, err := inferJsonType(goType, nil)
+	if err == nil {
+		return jsonType, goType, nil
+	}
+
+	if underlying := underlyingType(goType); underlying != goType {
+		if jsonType, underlyingErr := inferJsonType(underlying, nil); underlyingErr == nil {
+			return jsonType, underlying, nil
+		}
+	}
+
+	return "", nil, err
+}
+
+// structFields returns all the fields of a struct type that have a `typedjson` tag
+func structFields(structType reflect.Type) ([]structField, error) {
+	fields := []structField{}
+	for index := range structType.NumField() {
+		field := structType.Field(index)
+
+		tag, ok := field.Tag.Lookup("typedjson")
+		if !ok || tag == "-" {
+			continue
+		}
+
+		if !field.IsExported() {
+			return nil, fmt.Errorf("field '%s' has a typedjson tag but is not exported", field.Name)
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		parsed := structField{index: index, name: name}
+		if parsed.name == "" {
+			parsed.name = field.Name
+		}
+
+		for _, option := range strings.Split(options, ",") {
+			switch {
+			case option == "":
+			case option == "omitempty":
+				parsed.omitEmpty = true
+			case strings.HasPrefix(option, "type="):
+				parsed.jsonType = JSONTYPE(strings.TrimPrefix(option, "type="))
+			default:
+				return nil, fmt.Errorf("field '%s' has an unknown typedjson option '%s'", field.Name, option)
+			}
+		}
+
+		if err := parsed.resolve(field.Type); err != nil {
+			return nil, fmt.Errorf("field '%s': %w", field.Name, err)
+		}
+
+		fields = append(fields, parsed)
+	}
+
+	return fields, nil
+}
+
+// resolve sets how the field is encoded from the Go type of the field and the tag's type
+func (field *structField) resolve(fieldType reflect.Type) error {
+	// pointers are dereferenced unless the pointer itself is the Go type, like *big.Int
+	if fieldType.Kind() == reflect.Pointer {
+		if _, _, err := inferField(fieldType); err != nil || (field.jsonType != "" && !field.matches(fieldType)) {
+			field.pointer = true
+			fieldType = fieldType.Elem()
+		}
+	}
+
+	if field.jsonType != "" {
+		goType, ok := goTypeOf(field.jsonType)
+		if !ok {
+			// custom types are decoded as the field's own Go type
+			goType = fieldType
+		}
+
+		field.nested = field.jsonType == OBJECT && fieldType.Kind() == reflect.Struct
+		field.goType = goType
+		return nil
+	}
+
+	jsonType, goType, err := inferField(fieldType)
+	if err != nil {
+		if fieldType.Kind() != reflect.Struct {
+			return err
+		}
+
+		jsonType, goType, field.nested = OBJECT, scalarTypes[OBJECT], true
+	}
+
+	field.jsonType, field.goType = jsonType, goType
+	return nil
+}
+
+// matches reports if the tag's type is encoded from exactly the Go type
+func (field *structField) matches(goType reflect.Type) bool {
+	tagType, ok := goTypeOf(field.jsonType)
+	return ok && tagType == goType
+}
+
+// isEmpty returns true for the zero value, or an empty slice or map
+func isEmpty(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	default:
+		return value.IsZero()
+	}
+}
+
+// convertTo converts a value to a Go type with the same underlying type. Slices, arrays and maps are converted
+// element by element, so a [2]int is converted to a []int and a []Status to a []string. Integers are converted
+// between sizes when the value fits, so an int field can use the `type=_int64` tag.
+func convertTo(value reflect.Value, goType reflect.Type) (reflect.Value, error) {
+	if value.Type() == goType {
+		return value, nil
+	}
+
+	switch {
+	case (value.Kind() == reflect.Slice || value.Kind() == reflect.Array) && (goType.Kind() == reflect.Slice || goType.Kind() == reflect.Array):
+		if value.Kind() == reflect.Slice && value.IsNil() && goType.Kind() == reflect.Slice {
+			return reflect.Zero(goType), nil
+		}
+
+		converted := reflect.New(goType).Elem()
+		if goType.Kind() == reflect.Array {
+			if value.Len() != goType.Len() {
+				return reflect.Value{}, fmt.Errorf("failed to cast '%v' to %s: expected %d elements", value.Interface(), withArticle(goType), goType.Len())
+			}
+		} else {
+			converted = reflect.MakeSlice(goType, value.Len(), value.Len())
+		}
+
+		for index := range value.Len() {
+			element, err := convertTo(value.Index(index), goType.Elem())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			converted.Index(index).Set(element)
+		}
+
+		return converted, nil
+	case value.Kind() == reflect.Map && goType.Kind() == reflect.Map:
+		if value.IsNil() {
+			return reflect.Zero(goType), nil
+		}
+
+		converted := reflect.MakeMapWithSize(goType, value.Len())
+		for iter := value.MapRange(); iter.Next(); {
+			key, err := convertTo(iter.Key(), goType.Key())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			element, err := convertTo(iter.Value(), goType.Elem())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			converted.SetMapIndex(key, element)
+		}
+
+		return converted, nil
+	case value.Kind() == goType.Kind() && value.Type().ConvertibleTo(goType):
+		return value.Convert(goType), nil
+	case isInteger(value.Kind()) && isInteger(goType.Kind()):
+		// integers are converted between sizes as long as the value is represented exactly
+		converted := reflect.New(goType).Elem()
+		if err := convertNumber(value, converted); err != nil {
+			return reflect.Value{}, fmt.Errorf("failed to cast '%v' to %s: %w", value.Interface(), withArticle(goType), err)
+		}
+
+		return converted, nil
+	default:
+		return reflect.Value{}, fmt.Errorf("failed to cast '%v' to %s", value.Interface(), withArticle(goType))
+	}
+}
+
+// isInteger reports if the kind is a signed or unsigned integer
+func isInteger(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+// withArticle returns the name of a Go type with "a" or "an" in front of it, such as "an int64" or "a uint8"
+func withArticle(goType reflect.Type) string {
+	if strings.ContainsAny(goType.String()[:1], "aeio") {
+		return "an " + goType.String()
+	}
+
+	return "a " + goType.String()
+}
+
+// structToObject encodes the tagged fields of a struct as the Value of an OBJECT
+func structToObject(value reflect.Value) (map[string]*TypedJson, error) {
+	fields, err := structFields(value.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	objects := map[string]*TypedJson{}
+	for _, field := range fields {
+		fieldValue := value.Field(field.index)
+		if field.omitEmpty && isEmpty(fieldValue) {
+			continue
+		}
+
+		object := &TypedJson{Type: field.jsonType}
+		objects[field.name] = object
+
+		if field.pointer {
+			if fieldValue.IsNil() {
+				continue
+			}
+
+			fieldValue = fieldValue.Elem()
+		}
+
+		if field.nested {
+			nested, err := structToObject(fieldValue)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode field '%s': %w", field.name, err)
+			}
+
+			object.Value = nested
+			continue
+		}
+
+		converted, err := convertTo(fieldValue, field.goType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode field '%s': %w", field.name, err)
+		}
+
+		object.Value = converted.Interface()
+	}
+
+	return objects, nil
+}
+
+// objectToStruct decodes the Value of an OBJECT into the tagged fields of a struct
+func objectToStruct(objects map[string]*TypedJson, value reflect.Value) error {
+	fields, err := structFields(value.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, field := range fields {
+		object, ok := objects[field.name]
+		if !ok {
+			continue
+		}
+
+		fieldValue := value.Field(field.index)
+		if object == nil {
+			fieldValue.SetZero()
+			continue
+		}
+
+		if object.Type != field.jsonType {
+			return fmt.Errorf("failed to decode field '%s': type '%s' does not match '%s'", field.name, object.Type, field.jsonType)
+		}
+
+		// null is decoded as the zero value, or a nil pointer
+		if object.Value == nil {
+			fieldValue.SetZero()
+			continue
+		}
+
+		target := fieldValue
+		if field.pointer {
+			target = reflect.New(fieldValue.Type().Elem()).Elem()
+		}
+
+		if field.nested {
+			nested, ok := object.Value.(map[string]*TypedJson)
+			if !ok {
+				return fmt.Errorf("failed to decode field '%s': failed to cast '%v' to a map[string]*TypedJson", field.name, object.Value)
+			}
+
+			if err := objectToStruct(nested, target); err != nil {
+				return fmt.Errorf("failed to decode field '%s': %w", field.name, err)
+			}
+		} else {
+			converted, err := convertTo(reflect.ValueOf(object.Value), target.Type())
+			if err != nil {
+				return fmt.Errorf("failed to decode field '%s': %w", field.name, err)
+			}
+
+			target.Set(converted)
+		}
+
+		if field.pointer {
+			fieldValue.Set(target.Addr())
+		}
+	}
+
+	return nil
+}
+
+//	PARAMETERS:
+//	* v - struct or pointer to a struct to encode
+//
+//	RETURNS:
+//	* []byte - json object where each tagged field is an encoded TypedJson
+//	* error  - error if any of the fields could not be encoded
+//
+// Marshal encodes the fields of a struct that have a `typedjson:"name,type=_int64,omitempty"` tag. Each field is
+// encoded as a TypedJson under the name, or the field name when the name is empty. When the type is omitted, it is
+// inferred from the Go type of the field the same way as NewInferredTypedJson. Fields without a tag, or with the tag
+// `typedjson:"-"`, are skipped. Custom types can be used by adding them to the GlobalCodec.
+//
+// Fields are converted to the Go type of their type when needed:
+//   - pointers are dereferenced, where a nil pointer is encoded as null
+//   - named types are converted to the type they are defined as, so `type Status string` is a STRING
+//   - arrays are encoded as slices, so a [2]int is an INT_SLICE
+//   - structs that do not have a type are encoded as an OBJECT of their own tagged fields
+func Marshal(v any) ([]byte, error) {
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("failed to marshal %T: expected a struct or pointer to a struct", v)
+	}
+
+	objects, err := structToObject(value)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := map[string]json.RawMessage{}
+	for name, object := range objects {
+		data, err := object.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode field '%s': %w", name, err)
+		}
+
+		encoded[name] = data
+	}
+
+	return encodeJson(encoded)
+}
+
+//	PARAMETERS:
+//	* data - json object that was encoded with Marshal
+//	* v    - pointer to a struct to decode into
+//
+//	RETURNS:
+//	* error - error if any of the fields could not be decoded
+//
+// Unmarshal decodes a json object encoded by Marshal into the tagged fields of a struct. The decoded Type of each
+// field must match the tag's type, or the type inferred from the Go type of the field. Fields that are missing from
+// the data are left unchanged and keys without a matching field are ignored. Pointer fields are allocated, and null
+// is decoded as a nil pointer or the zero value.
+func Unmarshal(data []byte, v any) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("failed to unmarshal into %T: expected a pointer to a struct", v)
+	}
+
+	objects := map[string]*TypedJson{}
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return err
+	}
+
+	return objectToStruct(objects, value.Elem())
+}
@@ -0,0 +1,243 @@
+package gotypedjson_test
+
+import (
+	"testing"
+	"time"
+
+	gotypedjson "github.com/DanLavine/go-typed-json"
+	. "github.com/onsi/gomega"
+)
+
+type testDocument struct {
+	Count    int64          `typedjson:"count"`
+	Ratio    float32        `typedjson:",omitempty"`
+	Tags     []string       `typedjson:"tags,omitempty"`
+	Payload  []byte         `typedjson:"payload,type=_bytes_url"`
+	Created  time.Time      `typedjson:"created"`
+	Duration *time.Duration `typedjson:"-"`
+	Ignored  string
+}
+
+type testStatusDocument struct {
+	Status testStatus `typedjson:"status"`
+}
+
+type testInnerDocument struct {
+	Name  string `typedjson:"name"`
+	Other string
+}
+
+type testConvertedDocument struct {
+	Limit    *int64             `typedjson:"limit"`
+	Status   testStatus         `typedjson:"status,type=_string"`
+	Statuses []testStatus       `typedjson:"statuses"`
+	Pair     [2]int             `typedjson:"pair"`
+	Inner    testInnerDocument  `typedjson:"inner"`
+	Parent   *testInnerDocument `typedjson:"parent"`
+}
+
+func Test_Marshal(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("It returns an error for a non struct value", func(t *testing.T) {
+		_, err := gotypedjson.Marshal(5)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("failed to marshal int: expected a struct or pointer to a struct"))
+	})
+
+	t.Run("It returns an error for a field type that can not be inferred", func(t *testing.T) {
+		_, err := gotypedjson.Marshal(struct {
+			Value chan int `typedjson:"value"`
+		}{})
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("field 'Value': failed to infer the type of chan int"))
+	})
+
+	t.Run("It returns an error for an unknown tag option", func(t *testing.T) {
+		_, err := gotypedjson.Marshal(struct {
+			Value int `typedjson:"value,omitnil"`
+		}{})
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("field 'Value' has an unknown typedjson option 'omitnil'"))
+	})
+
+	t.Run("It returns an error when the field does not match the tag's type", func(t *testing.T) {
+		_, err := gotypedjson.Marshal(struct {
+			Value string `typedjson:"value,type=_int64"`
+		}{Value: "1"})
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("failed to encode field 'value': failed to cast '1' to an int64"))
+	})
+
+	t.Run("It converts integers to the size of the tag's type", func(t *testing.T) {
+		data, err := gotypedjson.Marshal(struct {
+			Value int `typedjson:"value,type=_int64"`
+			Small int `typedjson:"small,type=_uint8"`
+		}{Value: 1, Small: 2})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(data)).To(Equal(`{"small":{"Type":"_uint8","Value":"2"},"value":{"Type":"_int64","Value":"1"}}`))
+
+		_, err = gotypedjson.Marshal(struct {
+			Small int `typedjson:"small,type=_uint8"`
+		}{Small: -1})
+		g.Expect(err).To(MatchError(gotypedjson.ErrSignLoss))
+		g.Expect(err.Error()).To(Equal("failed to encode field 'small': failed to cast '-1' to a uint8: value loses its sign in the target type"))
+	})
+
+	t.Run("It encodes each tagged field", func(t *testing.T) {
+		data, err := gotypedjson.Marshal(&testDocument{
+			Count:   5,
+			Tags:    []string{"a"},
+			Payload: []byte{0xfb},
+			Created: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Ignored: "ignored",
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(data)).To(Equal(`{"count":{"Type":"_int64","Value":"5"},"created":{"Type":"_datetime","Value":"2024-01-02T03:04:05Z"},"payload":{"Type":"_bytes_url","Value":"-w=="},"tags":{"Type":"_string_array","Value":"YQ=="}}`))
+	})
+
+	t.Run("It infers custom types from the GlobalCodec", func(t *testing.T) {
+		gotypedjson.GlobalCodec = gotypedjson.CustomCodec{"status": gotypedjson.NewEnumCodec(testStatusActive)}
+		defer func() { gotypedjson.GlobalCodec = nil }()
+
+		data, err := gotypedjson.Marshal(testStatusDocument{Status: testStatusActive})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(data)).To(Equal(`{"status":{"Type":"status","Value":"active"}}`))
+
+		document := testStatusDocument{}
+		g.Expect(gotypedjson.Unmarshal(data, &document)).ToNot(HaveOccurred())
+		g.Expect(document.Status).To(Equal(testStatusActive))
+	})
+
+	t.Run("It dereferences pointer fields", func(t *testing.T) {
+		limit := int64(7)
+
+		data, err := gotypedjson.Marshal(struct {
+			Limit *int64 `typedjson:"limit"`
+			Unset *int64 `typedjson:"unset"`
+		}{Limit: &limit})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(data)).To(Equal(`{"limit":{"Type":"_int64","Value":"7"},"unset":{"Type":"_int64","Value":null}}`))
+	})
+
+	t.Run("It converts named types to the type they are defined as", func(t *testing.T) {
+		data, err := gotypedjson.Marshal(struct {
+			Inferred testStatus   `typedjson:"inferred"`
+			Tagged   testStatus   `typedjson:"tagged,type=_string"`
+			Statuses []testStatus `typedjson:"statuses"`
+		}{Inferred: testStatusActive, Tagged: testStatusInactive, Statuses: []testStatus{testStatusActive}})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(data)).To(Equal(`{"inferred":{"Type":"_string","Value":"active"},"statuses":{"Type":"_string_array","Value":"YWN0aXZl"},"tagged":{"Type":"_string","Value":"inactive"}}`))
+	})
+
+	t.Run("It encodes arrays as slices", func(t *testing.T) {
+		data, err := gotypedjson.Marshal(struct {
+			Pair [2]int `typedjson:"pair"`
+		}{Pair: [2]int{1, 2}})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(data)).To(Equal(`{"pair":{"Type":"_int_array","Value":"1,2"}}`))
+	})
+
+	t.Run("It encodes nested structs as an OBJECT of their tagged fields", func(t *testing.T) {
+		data, err := gotypedjson.Marshal(struct {
+			Inner  testInnerDocument  `typedjson:"inner"`
+			Parent *testInnerDocument `typedjson:"parent"`
+		}{Inner: testInnerDocument{Name: "inner", Other: "ignored"}})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(data)).To(Equal(`{"inner":{"Type":"_object","Value":{"name":{"Type":"_string","Value":"inner"}}},"parent":{"Type":"_object","Value":null}}`))
+	})
+}
+
+func Test_Unmarshal(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("It returns an error for a non struct pointer", func(t *testing.T) {
+		err := gotypedjson.Unmarshal([]byte(`{}`), testDocument{})
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("failed to unmarshal into gotypedjson_test.testDocument: expected a pointer to a struct"))
+	})
+
+	t.Run("It returns an error when the Type does not match the field", func(t *testing.T) {
+		err := gotypedjson.Unmarshal([]byte(`{"count":{"Type":"_int32","Value":"5"}}`), &testDocument{})
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("failed to decode field 'count': type '_int32' does not match '_int64'"))
+	})
+
+	t.Run("It returns an error for an invalid value", func(t *testing.T) {
+		err := gotypedjson.Unmarshal([]byte(`{"count":{"Type":"_int64","Value":"five"}}`), &testDocument{})
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("It decodes each tagged field", func(t *testing.T) {
+		document := &testDocument{Ratio: 1.5, Ignored: "kept"}
+
+		err := gotypedjson.Unmarshal([]byte(`{"count":{"Type":"_int64","Value":"5"},"tags":{"Type":"_string_array","Value":null},"payload":{"Type":"_bytes_url","Value":"-w=="},"other":{"Type":"_bool","Value":"true"}}`), document)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(document).To(Equal(&testDocument{Count: 5, Ratio: 1.5, Payload: []byte{0xfb}, Ignored: "kept"}))
+	})
+
+	t.Run("It round trips with Marshal", func(t *testing.T) {
+		original := testDocument{
+			Count:   -1,
+			Ratio:   0.25,
+			Tags:    []string{"a", "b"},
+			Payload: []byte("hello"),
+			Created: time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC),
+		}
+
+		data, err := gotypedjson.Marshal(original)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		decoded := testDocument{}
+		g.Expect(gotypedjson.Unmarshal(data, &decoded)).ToNot(HaveOccurred())
+		g.Expect(decoded).To(Equal(original))
+	})
+
+	t.Run("It returns an error when an array does not have the same number of elements", func(t *testing.T) {
+		err := gotypedjson.Unmarshal([]byte(`{"pair":{"Type":"_int_array","Value":"1,2,3"}}`), &testConvertedDocument{})
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("failed to decode field 'pair': failed to cast '[1 2 3]' to a [2]int: expected 2 elements"))
+	})
+
+	t.Run("It returns an error when an integer does not fit in the field", func(t *testing.T) {
+		document := struct {
+			Value int8 `typedjson:"value,type=_int64"`
+		}{}
+
+		err := gotypedjson.Unmarshal([]byte(`{"value":{"Type":"_int64","Value":"300"}}`), &document)
+		g.Expect(err).To(MatchError(gotypedjson.ErrOverflow))
+		g.Expect(err.Error()).To(Equal("failed to decode field 'value': failed to cast '300' to an int8: value overflows the target type"))
+
+		g.Expect(gotypedjson.Unmarshal([]byte(`{"value":{"Type":"_int64","Value":"-100"}}`), &document)).ToNot(HaveOccurred())
+		g.Expect(document.Value).To(Equal(int8(-100)))
+	})
+
+	t.Run("It decodes null as a nil pointer", func(t *testing.T) {
+		limit := int64(3)
+		document := &testConvertedDocument{Limit: &limit, Parent: &testInnerDocument{}}
+
+		err := gotypedjson.Unmarshal([]byte(`{"limit":{"Type":"_int64","Value":null},"parent":{"Type":"_object","Value":null}}`), document)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(document.Limit).To(BeNil())
+		g.Expect(document.Parent).To(BeNil())
+	})
+
+	t.Run("It round trips pointers, named types, arrays and nested structs", func(t *testing.T) {
+		limit := int64(-4)
+		original := testConvertedDocument{
+			Limit:    &limit,
+			Status:   testStatusActive,
+			Statuses: []testStatus{testStatusInactive, testStatusActive},
+			Pair:     [2]int{1, 2},
+			Inner:    testInnerDocument{Name: "inner"},
+			Parent:   &testInnerDocument{Name: "parent"},
+		}
+
+		data, err := gotypedjson.Marshal(original)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		decoded := testConvertedDocument{}
+		g.Expect(gotypedjson.Unmarshal(data, &decoded)).ToNot(HaveOccurred())
+		g.Expect(decoded).To(Equal(original))
+	})
+}
@@ -0,0 +1,51 @@
+package gotypedjson
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// compactJson removes all insignificant whitespace from the Value of a JSON type, the same as json.Marshal does to
+// the output of MarshalJSON. Html characters are not escaped.
+func compactJson(value json.RawMessage) json.RawMessage {
+	compacted := &bytes.Buffer{}
+	if err := json.Compact(compacted, value); err != nil {
+		// the value was already validated, so this can not happen
+		return value
+	}
+
+	return compacted.Bytes()
+}
+
+// encodeJson encodes a value without escaping html characters
+func encodeJson(value any) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
+
+// encodeTyped encodes the Type and Value of a TypedJson. A json.RawMessage Value is written exactly as it is.
+func encodeTyped(jsonType JSONTYPE, value any) ([]byte, error) {
+	raw, ok := value.(json.RawMessage)
+	if !ok {
+		return encodeJson(struct {
+			Type  JSONTYPE `json:"Type"`
+			Value any      `json:"Value"`
+		}{Type: jsonType, Value: value})
+	}
+
+	typeData, err := encodeJson(jsonType)
+	if err != nil {
+		return nil, err
+	}
+
+	data := append([]byte(`{"Type":`), typeData...)
+	data = append(append(data, `,"Value":`...), raw...)
+	return append(data, '}'), nil
+}
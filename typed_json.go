@@ -4,6 +4,12 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"net"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -56,14 +62,318 @@ const (
 	TIME_DURATION_SLICE JSONTYPE = "_duration_array"
 	COMPLEX64_SLICE     JSONTYPE = "_complex64_array"
 	COMPLEX128_SLICE    JSONTYPE = "_complex128_array"
+
+	// maps with string keys and a single value type
+	STRING_INT_MAP           JSONTYPE = "_string_int_map"
+	STRING_INT8_MAP          JSONTYPE = "_string_int8_map"
+	STRING_INT16_MAP         JSONTYPE = "_string_int16_map"
+	STRING_INT32_MAP         JSONTYPE = "_string_int32_map"
+	STRING_INT64_MAP         JSONTYPE = "_string_int64_map"
+	STRING_UINT_MAP          JSONTYPE = "_string_uint_map"
+	STRING_UINT8_MAP         JSONTYPE = "_string_uint8_map"
+	STRING_UINT16_MAP        JSONTYPE = "_string_uint16_map"
+	STRING_UINT32_MAP        JSONTYPE = "_string_uint32_map"
+	STRING_UINT64_MAP        JSONTYPE = "_string_uint64_map"
+	STRING_FLOAT32_MAP       JSONTYPE = "_string_float32_map"
+	STRING_FLOAT64_MAP       JSONTYPE = "_string_float64_map"
+	STRING_STRING_MAP        JSONTYPE = "_string_string_map"
+	STRING_BOOL_MAP          JSONTYPE = "_string_bool_map"
+	STRING_DATETIME_MAP      JSONTYPE = "_string_datetime_map"
+	STRING_TIME_DURATION_MAP JSONTYPE = "_string_duration_map"
+	STRING_COMPLEX64_MAP     JSONTYPE = "_string_complex64_map"
+	STRING_COMPLEX128_MAP    JSONTYPE = "_string_complex128_map"
+
+	// arbitrary precision numbers
+	BIG_INT         JSONTYPE = "_bigint"
+	BIG_FLOAT       JSONTYPE = "_bigfloat"
+	BIG_RAT         JSONTYPE = "_bigrat"
+	BIG_INT_SLICE   JSONTYPE = "_bigint_array"
+	BIG_FLOAT_SLICE JSONTYPE = "_bigfloat_array"
+	BIG_RAT_SLICE   JSONTYPE = "_bigrat_array"
+
+	STRING_BIG_INT_MAP   JSONTYPE = "_string_bigint_map"
+	STRING_BIG_FLOAT_MAP JSONTYPE = "_string_bigfloat_map"
+	STRING_BIG_RAT_MAP   JSONTYPE = "_string_bigrat_map"
+
+	// 128 bit integers
+	INT128        JSONTYPE = "_int128"
+	UINT128       JSONTYPE = "_uint128"
+	INT128_SLICE  JSONTYPE = "_int128_array"
+	UINT128_SLICE JSONTYPE = "_uint128_array"
+
+	STRING_INT128_MAP  JSONTYPE = "_string_int128_map"
+	STRING_UINT128_MAP JSONTYPE = "_string_uint128_map"
+
+	// fixed point decimal
+	DECIMAL            JSONTYPE = "_decimal"
+	STRING_DECIMAL_MAP JSONTYPE = "_string_decimal_map"
+
+	// calendar dates and wall clock times without a location
+	DATE              JSONTYPE = "_date"
+	TIME_OF_DAY       JSONTYPE = "_time_of_day"
+	DATE_SLICE        JSONTYPE = "_date_array"
+	TIME_OF_DAY_SLICE JSONTYPE = "_time_of_day_array"
+
+	STRING_DATE_MAP        JSONTYPE = "_string_date_map"
+	STRING_TIME_OF_DAY_MAP JSONTYPE = "_string_time_of_day_map"
+
+	// universally unique identifiers
+	UUID            JSONTYPE = "_uuid"
+	UUID_SLICE      JSONTYPE = "_uuid_array"
+	STRING_UUID_MAP JSONTYPE = "_string_uuid_map"
+
+	// network addresses
+	IP              JSONTYPE = "_ip"
+	IP_PREFIX       JSONTYPE = "_ip_prefix"
+	ADDR_PORT       JSONTYPE = "_addr_port"
+	MAC             JSONTYPE = "_mac"
+	IP_SLICE        JSONTYPE = "_ip_array"
+	IP_PREFIX_SLICE JSONTYPE = "_ip_prefix_array"
+	ADDR_PORT_SLICE JSONTYPE = "_addr_port_array"
+	MAC_SLICE       JSONTYPE = "_mac_array"
+
+	STRING_IP_MAP        JSONTYPE = "_string_ip_map"
+	STRING_IP_PREFIX_MAP JSONTYPE = "_string_ip_prefix_map"
+	STRING_ADDR_PORT_MAP JSONTYPE = "_string_addr_port_map"
+	STRING_MAC_MAP       JSONTYPE = "_string_mac_map"
+
+	// uniform resource locators
+	URL            JSONTYPE = "_url"
+	STRING_URL_MAP JSONTYPE = "_string_url_map"
+
+	// binary data encoded as base64
+	BYTES         JSONTYPE = "_bytes"
+	BYTES_URL     JSONTYPE = "_bytes_url"
+	BYTES_RAW     JSONTYPE = "_bytes_raw"
+	BYTES_RAW_URL JSONTYPE = "_bytes_raw_url"
+
+	// nested TypedJson values
+	OBJECT JSONTYPE = "_object"
+	ARRAY  JSONTYPE = "_array"
+
+	// raw json that is passed through without being interpreted
+	JSON JSONTYPE = "_json"
 )
 
+// bytesEncodings are the base64 encodings used for each of the BYTES types
+var bytesEncodings = map[JSONTYPE]*base64.Encoding{
+	BYTES:         base64.StdEncoding,
+	BYTES_URL:     base64.URLEncoding,
+	BYTES_RAW:     base64.RawStdEncoding,
+	BYTES_RAW_URL: base64.RawURLEncoding,
+}
+
+// stringMap describes the values stored in one of the string keyed map types
+type stringMap struct {
+	// element is the type used to encode and decode each value in the map
+	element JSONTYPE
+	// goType is the exact map type that is encoded and decoded
+	goType reflect.Type
+}
+
+// stringMaps are the value types for each of the string keyed map types
+var stringMaps = map[JSONTYPE]stringMap{
+	STRING_INT_MAP:           {element: INT, goType: reflect.TypeOf(map[string]int{})},
+	STRING_INT8_MAP:          {element: INT8, goType: reflect.TypeOf(map[string]int8{})},
+	STRING_INT16_MAP:         {element: INT16, goType: reflect.TypeOf(map[string]int16{})},
+	STRING_INT32_MAP:         {element: INT32, goType: reflect.TypeOf(map[string]int32{})},
+	STRING_INT64_MAP:         {element: INT64, goType: reflect.TypeOf(map[string]int64{})},
+	STRING_UINT_MAP:          {element: UINT, goType: reflect.TypeOf(map[string]uint{})},
+	STRING_UINT8_MAP:         {element: UINT8, goType: reflect.TypeOf(map[string]uint8{})},
+	STRING_UINT16_MAP:        {element: UINT16, goType: reflect.TypeOf(map[string]uint16{})},
+	STRING_UINT32_MAP:        {element: UINT32, goType: reflect.TypeOf(map[string]uint32{})},
+	STRING_UINT64_MAP:        {element: UINT64, goType: reflect.TypeOf(map[string]uint64{})},
+	STRING_FLOAT32_MAP:       {element: FLOAT32, goType: reflect.TypeOf(map[string]float32{})},
+	STRING_FLOAT64_MAP:       {element: FLOAT64, goType: reflect.TypeOf(map[string]float64{})},
+	STRING_STRING_MAP:        {element: STRING, goType: reflect.TypeOf(map[string]string{})},
+	STRING_BOOL_MAP:          {element: BOOL, goType: reflect.TypeOf(map[string]bool{})},
+	STRING_DATETIME_MAP:      {element: DATETIME, goType: reflect.TypeOf(map[string]time.Time{})},
+	STRING_TIME_DURATION_MAP: {element: TIME_DURATION, goType: reflect.TypeOf(map[string]time.Duration{})},
+	STRING_COMPLEX64_MAP:     {element: COMPLEX64, goType: reflect.TypeOf(map[string]complex64{})},
+	STRING_COMPLEX128_MAP:    {element: COMPLEX128, goType: reflect.TypeOf(map[string]complex128{})},
+	STRING_BIG_INT_MAP:       {element: BIG_INT, goType: reflect.TypeOf(map[string]*big.Int{})},
+	STRING_BIG_FLOAT_MAP:     {element: BIG_FLOAT, goType: reflect.TypeOf(map[string]*big.Float{})},
+	STRING_BIG_RAT_MAP:       {element: BIG_RAT, goType: reflect.TypeOf(map[string]*big.Rat{})},
+	STRING_INT128_MAP:        {element: INT128, goType: reflect.TypeOf(map[string]Int128{})},
+	STRING_UINT128_MAP:       {element: UINT128, goType: reflect.TypeOf(map[string]Uint128{})},
+	STRING_DECIMAL_MAP:       {element: DECIMAL, goType: reflect.TypeOf(map[string]Decimal{})},
+	STRING_DATE_MAP:          {element: DATE, goType: reflect.TypeOf(map[string]Date{})},
+	STRING_TIME_OF_DAY_MAP:   {element: TIME_OF_DAY, goType: reflect.TypeOf(map[string]TimeOfDay{})},
+	STRING_UUID_MAP:          {element: UUID, goType: reflect.TypeOf(map[string]Uuid{})},
+	STRING_IP_MAP:            {element: IP, goType: reflect.TypeOf(map[string]netip.Addr{})},
+	STRING_IP_PREFIX_MAP:     {element: IP_PREFIX, goType: reflect.TypeOf(map[string]netip.Prefix{})},
+	STRING_ADDR_PORT_MAP:     {element: ADDR_PORT, goType: reflect.TypeOf(map[string]netip.AddrPort{})},
+	STRING_MAC_MAP:           {element: MAC, goType: reflect.TypeOf(map[string]net.HardwareAddr{})},
+	STRING_URL_MAP:           {element: URL, goType: reflect.TypeOf(map[string]*url.URL{})},
+}
+
+// sliceTypes are the Go types of all the 1 dimensional slice types that can be nested with SliceOf
+var sliceTypes = map[JSONTYPE]reflect.Type{
+	INT_SLICE:           reflect.TypeOf([]int{}),
+	INT8_SLICE:          reflect.TypeOf([]int8{}),
+	INT16_SLICE:         reflect.TypeOf([]int16{}),
+	INT32_SLICE:         reflect.TypeOf([]int32{}),
+	INT64_SLICE:         reflect.TypeOf([]int64{}),
+	UINT_SLICE:          reflect.TypeOf([]uint{}),
+	UINT8_SLICE:         reflect.TypeOf([]uint8{}),
+	UINT16_SLICE:        reflect.TypeOf([]uint16{}),
+	UINT32_SLICE:        reflect.TypeOf([]uint32{}),
+	UINT64_SLICE:        reflect.TypeOf([]uint64{}),
+	FLOAT32_SLICE:       reflect.TypeOf([]float32{}),
+	FLOAT64_SLICE:       reflect.TypeOf([]float64{}),
+	STRING_SLICE:        reflect.TypeOf([]string{}),
+	BOOL_SLICE:          reflect.TypeOf([]bool{}),
+	DATETIME_SLICE:      reflect.TypeOf([]time.Time{}),
+	TIME_DURATION_SLICE: reflect.TypeOf([]time.Duration{}),
+	COMPLEX64_SLICE:     reflect.TypeOf([]complex64{}),
+	COMPLEX128_SLICE:    reflect.TypeOf([]complex128{}),
+	BIG_INT_SLICE:       reflect.TypeOf([]*big.Int{}),
+	BIG_FLOAT_SLICE:     reflect.TypeOf([]*big.Float{}),
+	BIG_RAT_SLICE:       reflect.TypeOf([]*big.Rat{}),
+	INT128_SLICE:        reflect.TypeOf([]Int128{}),
+	UINT128_SLICE:       reflect.TypeOf([]Uint128{}),
+	DATE_SLICE:          reflect.TypeOf([]Date{}),
+	TIME_OF_DAY_SLICE:   reflect.TypeOf([]TimeOfDay{}),
+	UUID_SLICE:          reflect.TypeOf([]Uuid{}),
+	IP_SLICE:            reflect.TypeOf([]netip.Addr{}),
+	IP_PREFIX_SLICE:     reflect.TypeOf([]netip.Prefix{}),
+	ADDR_PORT_SLICE:     reflect.TypeOf([]netip.AddrPort{}),
+	MAC_SLICE:           reflect.TypeOf([]net.HardwareAddr{}),
+}
+
+//	PARAMETERS:
+//	* jsonType - slice type to nest inside of another slice
+//
+//	RETURNS:
+//	* JSONTYPE - type for a slice of the provided slice type
+//
+// Returns the type for a multi-dimensional slice. Any of the `_array` types for a 1 dimensional slice can be nested
+// to an arbitrary depth. For example SliceOf(FLOAT64_SLICE) is the "_float64_array_array" type for a [][]float64
+// and SliceOf(SliceOf(INT32_SLICE)) is the "_int32_array_array_array" type for a [][][]int32.
+func SliceOf(jsonType JSONTYPE) JSONTYPE {
+	return jsonType + "_array"
+}
+
+// nestedSlice returns the 1 dimensional slice type and the exact Go type for a multi-dimensional slice type. The
+// boolean is false when the type is not a multi-dimensional slice.
+func nestedSlice(jsonType JSONTYPE) (JSONTYPE, reflect.Type, bool) {
+	dimensions := 0
+	for {
+		if goType, ok := sliceTypes[jsonType]; ok {
+			for range dimensions {
+				goType = reflect.SliceOf(goType)
+			}
+
+			return jsonType, goType, dimensions > 0
+		}
+
+		trimmed, ok := strings.CutSuffix(string(jsonType), "_array")
+		if !ok {
+			return "", nil, false
+		}
+
+		jsonType = JSONTYPE(trimmed)
+		dimensions++
+	}
+}
+
+// scalarTypes are the Go types of the default types that are not slices or maps of another default type. When
+// multiple types share a Go type, this is the type that is inferred from the Go type.
+var scalarTypes = map[JSONTYPE]reflect.Type{
+	INT:           reflect.TypeFor[int](),
+	INT8:          reflect.TypeFor[int8](),
+	INT16:         reflect.TypeFor[int16](),
+	INT32:         reflect.TypeFor[int32](),
+	INT64:         reflect.TypeFor[int64](),
+	UINT:          reflect.TypeFor[uint](),
+	UINT8:         reflect.TypeFor[uint8](),
+	UINT16:        reflect.TypeFor[uint16](),
+	UINT32:        reflect.TypeFor[uint32](),
+	UINT64:        reflect.TypeFor[uint64](),
+	FLOAT32:       reflect.TypeFor[float32](),
+	FLOAT64:       reflect.TypeFor[float64](),
+	STRING:        reflect.TypeFor[string](),
+	BOOL:          reflect.TypeFor[bool](),
+	DATETIME:      reflect.TypeFor[time.Time](),
+	TIME_DURATION: reflect.TypeFor[time.Duration](),
+	COMPLEX64:     reflect.TypeFor[complex64](),
+	COMPLEX128:    reflect.TypeFor[complex128](),
+	BIG_INT:       reflect.TypeFor[*big.Int](),
+	BIG_FLOAT:     reflect.TypeFor[*big.Float](),
+	BIG_RAT:       reflect.TypeFor[*big.Rat](),
+	INT128:        reflect.TypeFor[Int128](),
+	UINT128:       reflect.TypeFor[Uint128](),
+	DECIMAL:       reflect.TypeFor[Decimal](),
+	DATE:          reflect.TypeFor[Date](),
+	TIME_OF_DAY:   reflect.TypeFor[TimeOfDay](),
+	UUID:          reflect.TypeFor[Uuid](),
+	IP:            reflect.TypeFor[netip.Addr](),
+	IP_PREFIX:     reflect.TypeFor[netip.Prefix](),
+	ADDR_PORT:     reflect.TypeFor[netip.AddrPort](),
+	MAC:           reflect.TypeFor[net.HardwareAddr](),
+	URL:           reflect.TypeFor[*url.URL](),
+	BYTES:         reflect.TypeFor[[]byte](),
+	OBJECT:        reflect.TypeFor[map[string]*TypedJson](),
+	ARRAY:         reflect.TypeFor[[]*TypedJson](),
+	JSON:          reflect.TypeFor[json.RawMessage](),
+}
+
+// goTypes are the inferred types for each Go type that has a default type
+var goTypes = func() map[reflect.Type]JSONTYPE {
+	types := map[reflect.Type]JSONTYPE{}
+	for jsonType, goType := range sliceTypes {
+		types[goType] = jsonType
+	}
+
+	for jsonType, stringMap := range stringMaps {
+		types[stringMap.goType] = jsonType
+	}
+
+	// added last so []byte is inferred as BYTES rather than UINT8_SLICE
+	for jsonType, goType := range scalarTypes {
+		types[goType] = jsonType
+	}
+
+	return types
+}()
+
+// jsonTypeOf returns the default type that is inferred for a Go type, including multi-dimensional slices
+func jsonTypeOf(goType reflect.Type) (JSONTYPE, bool) {
+	if jsonType, ok := goTypes[goType]; ok {
+		return jsonType, true
+	}
+
+	if goType.Kind() != reflect.Slice || goType.Elem().Kind() != reflect.Slice {
+		return "", false
+	}
+
+	// the inner slice could be inferred as BYTES, so find the slice type directly
+	for jsonType, sliceType := range sliceTypes {
+		if sliceType == goType.Elem() {
+			return SliceOf(jsonType), true
+		}
+	}
+
+	jsonType, ok := jsonTypeOf(goType.Elem())
+	if !ok {
+		return "", false
+	}
+
+	if _, _, nested := nestedSlice(jsonType); !nested {
+		return "", false
+	}
+
+	return SliceOf(jsonType), true
+}
+
 // Codec are used to Encode and Decode JSONTYPE data
 type Codec struct {
 	// Encoded the data into a string for data integrity
 	Encode func(val any) (string, error)
 	// Decode an encoded string back into its original valie
 	Decode func(s string) (any, error)
+	// GoTypes are the optional Go types this codec encodes, used by NewInferredTypedJson to infer the JSONTYPE
+	GoTypes []reflect.Type
 }
 
 // CustomCodec are used to associate specific types with their encoding and decoding functions
@@ -115,6 +425,68 @@ func NewTypedJson(jsonType JSONTYPE, value any, customCodec CustomCodec) *TypedJ
 	}
 }
 
+//	PARAMETERS:
+//	* value       - Value to infer the Type from
+//	* customCodec - (optional) codec that can be used for custom types, nil will just use the global and then default codec
+//
+//	RETURNS:
+//	* *TypedJson - json object that can encode/decode typed json
+//	* error      - error if the Type could not be inferred
+//
+// Returns an initalized TypedJson where the Type is inferred from the Go type of the value. The same codec priority
+// as encoding is used, where the first codec with a single matching JSONTYPE is picked:
+//  1. customCodec - any codec with a GoTypes entry that matches the value
+//  2. GlobalCodec - any codec with a GoTypes entry that matches the value
+//  3. default     - the default type for the Go type, including multi-dimensional slices
+//
+// An error is returned if the value is nil, no type matches or multiple types in the same codec match. This can panic
+// if the custom codec is missing an encode or decode function for any of the defined types.
+func NewInferredTypedJson(value any, customCodec CustomCodec) (*TypedJson, error) {
+	if value == nil {
+		return nil, fmt.Errorf("failed to infer the type of a nil value")
+	}
+
+	jsonType, err := inferJsonType(reflect.TypeOf(value), customCodec)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTypedJson(jsonType, value, customCodec), nil
+}
+
+// inferJsonType returns the type for a Go type, checking the custom codec, then the GlobalCodec and then the
+// default types
+func inferJsonType(goType reflect.Type, customCodec CustomCodec) (JSONTYPE, error) {
+	for _, codec := range []CustomCodec{customCodec, GlobalCodec} {
+		matches := []string{}
+		for jsonType, encoder := range codec {
+			for _, codecType := range encoder.GoTypes {
+				if codecType == goType {
+					matches = append(matches, string(jsonType))
+					break
+				}
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			continue
+		case 1:
+			return JSONTYPE(matches[0]), nil
+		default:
+			sort.Strings(matches)
+			return "", fmt.Errorf("type %s is ambiguous between the types [%s]", goType, strings.Join(matches, ", "))
+		}
+	}
+
+	jsonType, ok := jsonTypeOf(goType)
+	if !ok {
+		return "", fmt.Errorf("failed to infer the type of %s", goType)
+	}
+
+	return jsonType, nil
+}
+
 //	PARAMETERS:
 //	* customCodec - (optional) codec that can be used for custom types, nil will just use the global and then default codec
 //
@@ -141,23 +513,34 @@ func NewTypedJsonDecoder(customCodec CustomCodec) *TypedJson {
 }
 
 func (typedJson *TypedJson) MarshalJSON() ([]byte, error) {
+	return typedJson.marshal(typedJson.customCodec)
+}
+
+// marshal encodes the TypedJson with the provided codec. Nested TypedJson values that do not have their own codec
+// are encoded with the codec of their parent.
+func (typedJson *TypedJson) marshal(customCodec CustomCodec) ([]byte, error) {
 	temp := struct {
 		Type  JSONTYPE `json:"Type"`
-		Value string   `json:"Value"`
+		Value any      `json:"Value"`
 	}{
 		Type: typedJson.Type,
 	}
 
+	// nil values are always encoded as null, regardless of the type
+	if isNil(typedJson.Value) {
+		return encodeTyped(temp.Type, temp.Value)
+	}
+
 	// might be a custom type
-	if typedJson.customCodec != nil {
-		if encoder, ok := typedJson.customCodec[typedJson.Type]; ok {
+	if customCodec != nil {
+		if encoder, ok := customCodec[typedJson.Type]; ok {
 			assignString, err := encoder.Encode(typedJson.Value)
 			if err != nil {
 				return nil, err
 			}
 
 			temp.Value = assignString
-			return json.Marshal(temp)
+			return encodeTyped(temp.Type, temp.Value)
 		}
 	}
 
@@ -170,566 +553,1203 @@ func (typedJson *TypedJson) MarshalJSON() ([]byte, error) {
 			}
 
 			temp.Value = assignString
-			return json.Marshal(temp)
+			return encodeTyped(temp.Type, temp.Value)
+		}
+	}
+
+	// NaN and ±Inf are encoded as null when using the FLOAT_NULL policy
+	if GlobalFloatPolicy == FLOAT_NULL && typedJson.isNonFinite() {
+		return encodeTyped(temp.Type, temp.Value)
+	}
+
+	// multi-dimensional slices are encoded as nested json arrays
+	if element, goType, ok := nestedSlice(typedJson.Type); ok {
+		values := reflect.ValueOf(typedJson.Value)
+		if values.Type() != goType {
+			return nil, fmt.Errorf("failed to cast '%v' to a %s", typedJson.Value, goType)
+		}
+
+		encoded, err := encodeNestedSlice(element, values)
+		if err != nil {
+			return nil, err
+		}
+
+		temp.Value = encoded
+		return encodeTyped(temp.Type, temp.Value)
+	}
+
+	// nested types are encoded as json rather than a string
+	switch typedJson.Type {
+	case OBJECT:
+		values, ok := typedJson.Value.(map[string]*TypedJson)
+		if !ok {
+			return nil, fmt.Errorf("failed to cast '%v' to a map[string]*TypedJson", typedJson.Value)
+		}
+
+		keys := make([]string, 0, len(values))
+		for key := range values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		// written by hand so nested raw json is not escaped or compacted again
+		objects := []byte{'{'}
+		for index, key := range keys {
+			data, err := values[key].marshalNested(customCodec)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode key '%s': %w", key, err)
+			}
+
+			keyData, err := encodeJson(key)
+			if err != nil {
+				return nil, err
+			}
+
+			if index != 0 {
+				objects = append(objects, ',')
+			}
+
+			objects = append(append(append(objects, keyData...), ':'), data...)
+		}
+
+		temp.Value = json.RawMessage(append(objects, '}'))
+	case ARRAY:
+		values, ok := typedJson.Value.([]*TypedJson)
+		if !ok {
+			return nil, fmt.Errorf("failed to cast '%v' to a []*TypedJson", typedJson.Value)
+		}
+
+		// written by hand so nested raw json is not escaped or compacted again
+		arrays := []byte{'['}
+		for index, value := range values {
+			data, err := value.marshalNested(customCodec)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode index %d: %w", index, err)
+			}
+
+			if index != 0 {
+				arrays = append(arrays, ',')
+			}
+
+			arrays = append(arrays, data...)
+		}
+
+		temp.Value = json.RawMessage(append(arrays, ']'))
+	case JSON:
+		value, ok := typedJson.Value.(json.RawMessage)
+		if !ok {
+			return nil, fmt.Errorf("failed to cast '%v' to json", typedJson.Value)
+		}
+
+		if !json.Valid(value) {
+			return nil, fmt.Errorf("failed to cast '%s' to json: invalid json", string(value))
+		}
+
+		temp.Value = compactJson(value)
+	case STRING_INT_MAP, STRING_INT8_MAP, STRING_INT16_MAP, STRING_INT32_MAP, STRING_INT64_MAP,
+		STRING_UINT_MAP, STRING_UINT8_MAP, STRING_UINT16_MAP, STRING_UINT32_MAP, STRING_UINT64_MAP,
+		STRING_FLOAT32_MAP, STRING_FLOAT64_MAP, STRING_STRING_MAP, STRING_BOOL_MAP, STRING_DATETIME_MAP,
+		STRING_TIME_DURATION_MAP, STRING_COMPLEX64_MAP, STRING_COMPLEX128_MAP, STRING_BIG_INT_MAP, STRING_BIG_FLOAT_MAP,
+		STRING_BIG_RAT_MAP, STRING_INT128_MAP, STRING_UINT128_MAP, STRING_DECIMAL_MAP, STRING_DATE_MAP,
+		STRING_TIME_OF_DAY_MAP, STRING_UUID_MAP, STRING_IP_MAP, STRING_IP_PREFIX_MAP, STRING_ADDR_PORT_MAP,
+		STRING_MAC_MAP, STRING_URL_MAP:
+		values, err := typedJson.encodeMap()
+		if err != nil {
+			return nil, err
+		}
+
+		temp.Value = values
+	default:
+		// check the defualt types
+		value, err := typedJson.encodeString()
+		if err != nil {
+			return nil, err
+		}
+
+		temp.Value = value
+	}
+
+	return encodeTyped(temp.Type, temp.Value)
+}
+
+// marshalNested encodes a TypedJson that is stored inside of another TypedJson. If the nested value does not have
+// its own codec, the parent's codec is used instead.
+func (typedJson *TypedJson) marshalNested(parentCodec CustomCodec) (json.RawMessage, error) {
+	if typedJson == nil {
+		return json.RawMessage("null"), nil
+	}
+
+	if typedJson.customCodec != nil {
+		return typedJson.marshal(typedJson.customCodec)
+	}
+
+	return typedJson.marshal(parentCodec)
+}
+
+// encodeMap encodes a string keyed map as a json object where each value is encoded with the map's value type.
+// Keys are always written in sorted order.
+func (typedJson *TypedJson) encodeMap() (map[string]string, error) {
+	stringMap := stringMaps[typedJson.Type]
+
+	values := reflect.ValueOf(typedJson.Value)
+	if values.Type() != stringMap.goType {
+		return nil, fmt.Errorf("failed to cast '%v' to a %s", typedJson.Value, stringMap.goType)
+	}
+
+	encoded := make(map[string]string, values.Len())
+	for iter := values.MapRange(); iter.Next(); {
+		value, err := (&TypedJson{Type: stringMap.element, Value: iter.Value().Interface()}).encodeString()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode key '%s': %w", iter.Key().String(), err)
 		}
+
+		encoded[iter.Key().String()] = value
 	}
 
-	// check the defualt types
+	return encoded, nil
+}
+
+// encodeString encodes all the default types that are represented as a single string
+func (typedJson *TypedJson) encodeString() (string, error) {
+	var encoded string
+
 	switch typedJson.Type {
 	case INT:
 		if _, ok := typedJson.Value.(int); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to an int", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to an int", typedJson.Value)
 		}
 
-		temp.Value = strconv.FormatInt(int64(typedJson.Value.(int)), 10)
+		encoded = strconv.FormatInt(int64(typedJson.Value.(int)), 10)
 	case INT8:
 		if _, ok := typedJson.Value.(int8); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to an int8", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to an int8", typedJson.Value)
 		}
 
-		temp.Value = strconv.FormatInt(int64(typedJson.Value.(int8)), 10)
+		encoded = strconv.FormatInt(int64(typedJson.Value.(int8)), 10)
 	case INT16:
 		if _, ok := typedJson.Value.(int16); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to an int16", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to an int16", typedJson.Value)
 		}
 
-		temp.Value = strconv.FormatInt(int64(typedJson.Value.(int16)), 10)
+		encoded = strconv.FormatInt(int64(typedJson.Value.(int16)), 10)
 	case INT32:
 		if _, ok := typedJson.Value.(int32); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to an int32", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to an int32", typedJson.Value)
 		}
 
-		temp.Value = strconv.FormatInt(int64(typedJson.Value.(int32)), 10)
+		encoded = strconv.FormatInt(int64(typedJson.Value.(int32)), 10)
 	case INT64:
 		if _, ok := typedJson.Value.(int64); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to an int64", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to an int64", typedJson.Value)
 		}
 
-		temp.Value = strconv.FormatInt(int64(typedJson.Value.(int64)), 10)
+		encoded = strconv.FormatInt(int64(typedJson.Value.(int64)), 10)
 	case UINT:
 		if _, ok := typedJson.Value.(uint); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to an uint", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to an uint", typedJson.Value)
 		}
 
-		temp.Value = strconv.FormatUint(uint64(typedJson.Value.(uint)), 10)
+		encoded = strconv.FormatUint(uint64(typedJson.Value.(uint)), 10)
 	case UINT8:
 		if _, ok := typedJson.Value.(uint8); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to an uint8", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to an uint8", typedJson.Value)
 		}
 
-		temp.Value = strconv.FormatUint(uint64(typedJson.Value.(uint8)), 10)
+		encoded = strconv.FormatUint(uint64(typedJson.Value.(uint8)), 10)
 	case UINT16:
 		if _, ok := typedJson.Value.(uint16); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to an uint16", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to an uint16", typedJson.Value)
 		}
 
-		temp.Value = strconv.FormatUint(uint64(typedJson.Value.(uint16)), 10)
+		encoded = strconv.FormatUint(uint64(typedJson.Value.(uint16)), 10)
 	case UINT32:
 		if _, ok := typedJson.Value.(uint32); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to an uint32", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to an uint32", typedJson.Value)
 		}
 
-		temp.Value = strconv.FormatUint(uint64(typedJson.Value.(uint32)), 10)
+		encoded = strconv.FormatUint(uint64(typedJson.Value.(uint32)), 10)
 	case UINT64:
 		if _, ok := typedJson.Value.(uint64); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to an uint64", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to an uint64", typedJson.Value)
 		}
 
-		temp.Value = strconv.FormatUint(uint64(typedJson.Value.(uint64)), 10)
+		encoded = strconv.FormatUint(uint64(typedJson.Value.(uint64)), 10)
 	case FLOAT32:
 		if _, ok := typedJson.Value.(float32); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to a float32", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to a float32", typedJson.Value)
+		}
+
+		value, err := formatFloat(float64(typedJson.Value.(float32)), 32, "float32")
+		if err != nil {
+			return "", err
 		}
 
-		temp.Value = strconv.FormatFloat(float64(typedJson.Value.(float32)), 'E', -1, 32)
+		encoded = value
 	case FLOAT64:
 		if _, ok := typedJson.Value.(float64); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to a float64", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to a float64", typedJson.Value)
+		}
+
+		value, err := formatFloat(float64(typedJson.Value.(float64)), 64, "float64")
+		if err != nil {
+			return "", err
 		}
 
-		temp.Value = strconv.FormatFloat(float64(typedJson.Value.(float64)), 'E', -1, 64)
+		encoded = value
 	case STRING:
 		if _, ok := typedJson.Value.(string); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to a string", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to a string", typedJson.Value)
 		}
 
-		temp.Value = typedJson.Value.(string)
+		encoded = typedJson.Value.(string)
 	case BOOL:
 		if _, ok := typedJson.Value.(bool); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to a bool", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to a bool", typedJson.Value)
 		}
 
-		temp.Value = strconv.FormatBool(typedJson.Value.(bool))
+		encoded = strconv.FormatBool(typedJson.Value.(bool))
 	case DATETIME:
 		if _, ok := typedJson.Value.(time.Time); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to a datetime", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to a datetime", typedJson.Value)
 		}
 
-		temp.Value = typedJson.Value.(time.Time).Format(time.RFC3339)
+		encoded = formatDatetime(typedJson.Value.(time.Time))
 	case TIME_DURATION:
 		if _, ok := typedJson.Value.(time.Duration); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to a time duration", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to a time duration", typedJson.Value)
 		}
 
-		temp.Value = typedJson.Value.(time.Duration).String()
+		encoded = typedJson.Value.(time.Duration).String()
 	case COMPLEX64:
 		if _, ok := typedJson.Value.(complex64); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to a complex64", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to a complex64", typedJson.Value)
 		}
 
-		temp.Value = strconv.FormatComplex(complex128(typedJson.Value.(complex64)), 'E', -1, 64)
+		value, err := formatComplex(complex128(typedJson.Value.(complex64)), 64, "complex64")
+		if err != nil {
+			return "", err
+		}
+
+		encoded = value
 	case COMPLEX128:
 		if _, ok := typedJson.Value.(complex128); !ok {
-			return nil, fmt.Errorf("failed to cast '%v' to a complex128", typedJson.Value)
+			return "", fmt.Errorf("failed to cast '%v' to a complex128", typedJson.Value)
+		}
+
+		value, err := formatComplex(typedJson.Value.(complex128), 128, "complex128")
+		if err != nil {
+			return "", err
 		}
 
-		temp.Value = strconv.FormatComplex(typedJson.Value.(complex128), 'E', -1, 64)
+		encoded = value
 	case INT_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]int); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = fmt.Sprintf("%d", value)
-					} else {
-						temp.Value += fmt.Sprintf(",%d", value)
-					}
+		if values, ok := typedJson.Value.([]int); ok {
+			for index, value := range values {
+				if index == 0 {
+					encoded = fmt.Sprintf("%d", value)
+				} else {
+					encoded += fmt.Sprintf(",%d", value)
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []int", typedJson.Value)
 			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []int", typedJson.Value)
 		}
 	case INT8_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]int8); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = fmt.Sprintf("%d", value)
-					} else {
-						temp.Value += fmt.Sprintf(",%d", value)
-					}
+		if values, ok := typedJson.Value.([]int8); ok {
+			for index, value := range values {
+				if index == 0 {
+					encoded = fmt.Sprintf("%d", value)
+				} else {
+					encoded += fmt.Sprintf(",%d", value)
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []int8", typedJson.Value)
 			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []int8", typedJson.Value)
 		}
 	case INT16_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]int16); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = fmt.Sprintf("%d", value)
-					} else {
-						temp.Value += fmt.Sprintf(",%d", value)
-					}
+		if values, ok := typedJson.Value.([]int16); ok {
+			for index, value := range values {
+				if index == 0 {
+					encoded = fmt.Sprintf("%d", value)
+				} else {
+					encoded += fmt.Sprintf(",%d", value)
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []int16", typedJson.Value)
 			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []int16", typedJson.Value)
 		}
 	case INT32_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]int32); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = fmt.Sprintf("%d", value)
-					} else {
-						temp.Value += fmt.Sprintf(",%d", value)
-					}
+		if values, ok := typedJson.Value.([]int32); ok {
+			for index, value := range values {
+				if index == 0 {
+					encoded = fmt.Sprintf("%d", value)
+				} else {
+					encoded += fmt.Sprintf(",%d", value)
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []int32", typedJson.Value)
 			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []int32", typedJson.Value)
 		}
 	case INT64_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]int64); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = fmt.Sprintf("%d", value)
-					} else {
-						temp.Value += fmt.Sprintf(",%d", value)
-					}
+		if values, ok := typedJson.Value.([]int64); ok {
+			for index, value := range values {
+				if index == 0 {
+					encoded = fmt.Sprintf("%d", value)
+				} else {
+					encoded += fmt.Sprintf(",%d", value)
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []int64", typedJson.Value)
 			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []int64", typedJson.Value)
 		}
 	case UINT_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]uint); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = fmt.Sprintf("%d", value)
-					} else {
-						temp.Value += fmt.Sprintf(",%d", value)
-					}
+		if values, ok := typedJson.Value.([]uint); ok {
+			for index, value := range values {
+				if index == 0 {
+					encoded = fmt.Sprintf("%d", value)
+				} else {
+					encoded += fmt.Sprintf(",%d", value)
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []uint", typedJson.Value)
 			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []uint", typedJson.Value)
 		}
 	case UINT8_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]uint8); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = fmt.Sprintf("%d", value)
-					} else {
-						temp.Value += fmt.Sprintf(",%d", value)
-					}
+		if values, ok := typedJson.Value.([]uint8); ok {
+			for index, value := range values {
+				if index == 0 {
+					encoded = fmt.Sprintf("%d", value)
+				} else {
+					encoded += fmt.Sprintf(",%d", value)
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []uint8", typedJson.Value)
 			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []uint8", typedJson.Value)
 		}
 	case UINT16_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]uint16); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = fmt.Sprintf("%d", value)
-					} else {
-						temp.Value += fmt.Sprintf(",%d", value)
-					}
+		if values, ok := typedJson.Value.([]uint16); ok {
+			for index, value := range values {
+				if index == 0 {
+					encoded = fmt.Sprintf("%d", value)
+				} else {
+					encoded += fmt.Sprintf(",%d", value)
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []uint16", typedJson.Value)
 			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []uint16", typedJson.Value)
 		}
 	case UINT32_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]uint32); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = fmt.Sprintf("%d", value)
-					} else {
-						temp.Value += fmt.Sprintf(",%d", value)
-					}
+		if values, ok := typedJson.Value.([]uint32); ok {
+			for index, value := range values {
+				if index == 0 {
+					encoded = fmt.Sprintf("%d", value)
+				} else {
+					encoded += fmt.Sprintf(",%d", value)
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []uint32", typedJson.Value)
 			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []uint32", typedJson.Value)
 		}
 	case UINT64_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]uint64); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = fmt.Sprintf("%d", value)
-					} else {
-						temp.Value += fmt.Sprintf(",%d", value)
-					}
+		if values, ok := typedJson.Value.([]uint64); ok {
+			for index, value := range values {
+				if index == 0 {
+					encoded = fmt.Sprintf("%d", value)
+				} else {
+					encoded += fmt.Sprintf(",%d", value)
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []uint64", typedJson.Value)
 			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []uint64", typedJson.Value)
 		}
 	case FLOAT32_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]float32); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = strconv.FormatFloat(float64(value), 'E', -1, 32)
-					} else {
-						temp.Value += fmt.Sprintf(",%s", strconv.FormatFloat(float64(value), 'E', -1, 32))
-					}
+		if values, ok := typedJson.Value.([]float32); ok {
+			for index, value := range values {
+				element, err := formatFloat(float64(value), 32, "float32")
+				if err != nil {
+					return "", err
+				}
+
+				if index == 0 {
+					encoded = element
+				} else {
+					encoded += "," + element
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []float32", typedJson.Value)
 			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []float32", typedJson.Value)
 		}
 	case FLOAT64_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]float64); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = strconv.FormatFloat(float64(value), 'E', -1, 64)
-					} else {
-						temp.Value += fmt.Sprintf(",%s", strconv.FormatFloat(float64(value), 'E', -1, 64))
-					}
+		if values, ok := typedJson.Value.([]float64); ok {
+			for index, value := range values {
+				element, err := formatFloat(float64(value), 64, "float64")
+				if err != nil {
+					return "", err
+				}
+
+				if index == 0 {
+					encoded = element
+				} else {
+					encoded += "," + element
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []float64", typedJson.Value)
 			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []float64", typedJson.Value)
 		}
 	case STRING_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]string); ok {
-				for index, value := range values {
-					str := base64.StdEncoding.EncodeToString([]byte(value))
-					if index == 0 {
-						temp.Value = str
-					} else {
-						temp.Value += "," + str
-					}
+		if values, ok := typedJson.Value.([]string); ok {
+			for index, value := range values {
+				str := base64.StdEncoding.EncodeToString([]byte(value))
+				if index == 0 {
+					encoded = str
+				} else {
+					encoded += "," + str
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []string", typedJson.Value)
 			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []string", typedJson.Value)
 		}
 	case BOOL_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]bool); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = strconv.FormatBool(value)
-					} else {
-						temp.Value += "," + strconv.FormatBool(value)
-					}
+		if values, ok := typedJson.Value.([]bool); ok {
+			for index, value := range values {
+				if index == 0 {
+					encoded = strconv.FormatBool(value)
+				} else {
+					encoded += "," + strconv.FormatBool(value)
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []bool", typedJson.Value)
 			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []bool", typedJson.Value)
 		}
 	case DATETIME_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]time.Time); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = value.Format(time.RFC3339)
-					} else {
-						temp.Value += fmt.Sprintf(",%s", value.Format(time.RFC3339))
-					}
+		if values, ok := typedJson.Value.([]time.Time); ok {
+			for index, value := range values {
+				if index == 0 {
+					encoded = formatDatetime(value)
+				} else {
+					encoded += "," + formatDatetime(value)
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []datetime", typedJson.Value)
 			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []datetime", typedJson.Value)
 		}
 	case TIME_DURATION_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]time.Duration); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = value.String()
-					} else {
-						temp.Value += fmt.Sprintf(",%s", value.String())
-					}
+		if values, ok := typedJson.Value.([]time.Duration); ok {
+			for index, value := range values {
+				if index == 0 {
+					encoded = value.String()
+				} else {
+					encoded += fmt.Sprintf(",%s", value.String())
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []duration", typedJson.Value)
 			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []duration", typedJson.Value)
 		}
 	case COMPLEX64_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
+		if values, ok := typedJson.Value.([]complex64); ok {
+			for index, value := range values {
+				element, err := formatComplex(complex128(value), 64, "complex64")
+				if err != nil {
+					return "", err
+				}
+
+				if index == 0 {
+					encoded = element
+				} else {
+					encoded += "," + element
+				}
+			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []complex64", typedJson.Value)
+		}
+	case COMPLEX128_SLICE:
+		if values, ok := typedJson.Value.([]complex128); ok {
+			for index, value := range values {
+				element, err := formatComplex(value, 128, "complex128")
+				if err != nil {
+					return "", err
+				}
+
+				if index == 0 {
+					encoded = element
+				} else {
+					encoded += "," + element
+				}
+			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []complex128", typedJson.Value)
+		}
+	case BIG_INT:
+		if value, ok := typedJson.Value.(*big.Int); !ok || value == nil {
+			return "", fmt.Errorf("failed to cast '%v' to a *big.Int", typedJson.Value)
+		}
+
+		encoded = typedJson.Value.(*big.Int).String()
+	case BIG_FLOAT:
+		if value, ok := typedJson.Value.(*big.Float); !ok || value == nil {
+			return "", fmt.Errorf("failed to cast '%v' to a *big.Float", typedJson.Value)
+		}
+
+		encoded = formatBigFloat(typedJson.Value.(*big.Float))
+	case BIG_RAT:
+		if value, ok := typedJson.Value.(*big.Rat); !ok || value == nil {
+			return "", fmt.Errorf("failed to cast '%v' to a *big.Rat", typedJson.Value)
+		}
+
+		encoded = typedJson.Value.(*big.Rat).String()
+	case BIG_INT_SLICE:
+		if values, ok := typedJson.Value.([]*big.Int); ok {
+			for index, value := range values {
+				if value == nil {
+					return "", fmt.Errorf("failed to cast '%v' to a *big.Int", value)
+				}
+
+				if index == 0 {
+					encoded = value.String()
+				} else {
+					encoded += "," + value.String()
+				}
+			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []*big.Int", typedJson.Value)
+		}
+	case BIG_FLOAT_SLICE:
+		if values, ok := typedJson.Value.([]*big.Float); ok {
+			for index, value := range values {
+				if value == nil {
+					return "", fmt.Errorf("failed to cast '%v' to a *big.Float", value)
+				}
+
+				if index == 0 {
+					encoded = formatBigFloat(value)
+				} else {
+					encoded += "," + formatBigFloat(value)
+				}
+			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []*big.Float", typedJson.Value)
+		}
+	case BIG_RAT_SLICE:
+		if values, ok := typedJson.Value.([]*big.Rat); ok {
+			for index, value := range values {
+				if value == nil {
+					return "", fmt.Errorf("failed to cast '%v' to a *big.Rat", value)
+				}
+
+				if index == 0 {
+					encoded = value.String()
+				} else {
+					encoded += "," + value.String()
+				}
+			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []*big.Rat", typedJson.Value)
+		}
+	case INT128:
+		if _, ok := typedJson.Value.(Int128); !ok {
+			return "", fmt.Errorf("failed to cast '%v' to an int128", typedJson.Value)
+		}
+
+		encoded = typedJson.Value.(Int128).String()
+	case UINT128:
+		if _, ok := typedJson.Value.(Uint128); !ok {
+			return "", fmt.Errorf("failed to cast '%v' to a uint128", typedJson.Value)
+		}
+
+		encoded = typedJson.Value.(Uint128).String()
+	case INT128_SLICE:
+		if values, ok := typedJson.Value.([]Int128); ok {
+			for index, value := range values {
+				if index == 0 {
+					encoded = value.String()
+				} else {
+					encoded += "," + value.String()
+				}
+			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []int128", typedJson.Value)
+		}
+	case UINT128_SLICE:
+		if values, ok := typedJson.Value.([]Uint128); ok {
+			for index, value := range values {
+				if index == 0 {
+					encoded = value.String()
+				} else {
+					encoded += "," + value.String()
+				}
+			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []uint128", typedJson.Value)
+		}
+	case DECIMAL:
+		if _, ok := typedJson.Value.(Decimal); !ok {
+			return "", fmt.Errorf("failed to cast '%v' to a decimal", typedJson.Value)
+		}
+
+		encoded = typedJson.Value.(Decimal).String()
+	case DATE:
+		if value, ok := typedJson.Value.(Date); !ok || !value.IsValid() {
+			return "", fmt.Errorf("failed to cast '%v' to a date", typedJson.Value)
+		}
+
+		encoded = typedJson.Value.(Date).String()
+	case TIME_OF_DAY:
+		if value, ok := typedJson.Value.(TimeOfDay); !ok || !value.IsValid() {
+			return "", fmt.Errorf("failed to cast '%v' to a time of day", typedJson.Value)
+		}
+
+		encoded = typedJson.Value.(TimeOfDay).String()
+	case DATE_SLICE:
+		if values, ok := typedJson.Value.([]Date); ok {
+			for index, value := range values {
+				if !value.IsValid() {
+					return "", fmt.Errorf("failed to cast '%v' to a date", value)
+				}
+
+				if index == 0 {
+					encoded = value.String()
+				} else {
+					encoded += "," + value.String()
+				}
+			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []date", typedJson.Value)
+		}
+	case TIME_OF_DAY_SLICE:
+		if values, ok := typedJson.Value.([]TimeOfDay); ok {
+			for index, value := range values {
+				if !value.IsValid() {
+					return "", fmt.Errorf("failed to cast '%v' to a time of day", value)
+				}
+
+				if index == 0 {
+					encoded = value.String()
+				} else {
+					encoded += "," + value.String()
+				}
+			}
 		} else {
-			if values, ok := typedJson.Value.([]complex64); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = strconv.FormatComplex(complex128(value), 'E', -1, 64)
-					} else {
-						temp.Value += "," + strconv.FormatComplex(complex128(value), 'E', -1, 64)
-					}
+			return "", fmt.Errorf("failed to cast '%v' to a []time of day", typedJson.Value)
+		}
+	case UUID:
+		if _, ok := typedJson.Value.(Uuid); !ok {
+			return "", fmt.Errorf("failed to cast '%v' to a uuid", typedJson.Value)
+		}
+
+		encoded = typedJson.Value.(Uuid).String()
+	case UUID_SLICE:
+		if values, ok := typedJson.Value.([]Uuid); ok {
+			for index, value := range values {
+				if index == 0 {
+					encoded = value.String()
+				} else {
+					encoded += "," + value.String()
 				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []complex64", typedJson.Value)
+			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []uuid", typedJson.Value)
+		}
+	case IP:
+		if value, ok := typedJson.Value.(netip.Addr); !ok || !value.IsValid() {
+			return "", fmt.Errorf("failed to cast '%v' to an ip", typedJson.Value)
+		}
+
+		encoded = typedJson.Value.(netip.Addr).String()
+	case IP_PREFIX:
+		if value, ok := typedJson.Value.(netip.Prefix); !ok || !value.IsValid() {
+			return "", fmt.Errorf("failed to cast '%v' to an ip prefix", typedJson.Value)
+		}
+
+		encoded = typedJson.Value.(netip.Prefix).String()
+	case ADDR_PORT:
+		if value, ok := typedJson.Value.(netip.AddrPort); !ok || !value.IsValid() {
+			return "", fmt.Errorf("failed to cast '%v' to an addr port", typedJson.Value)
+		}
+
+		encoded = typedJson.Value.(netip.AddrPort).String()
+	case MAC:
+		if value, ok := typedJson.Value.(net.HardwareAddr); !ok || len(value) == 0 {
+			return "", fmt.Errorf("failed to cast '%v' to a mac", typedJson.Value)
+		}
+
+		encoded = typedJson.Value.(net.HardwareAddr).String()
+	case IP_SLICE:
+		if values, ok := typedJson.Value.([]netip.Addr); ok {
+			for index, value := range values {
+				if !value.IsValid() {
+					return "", fmt.Errorf("failed to cast '%v' to an ip", value)
+				}
+
+				if index == 0 {
+					encoded = value.String()
+				} else {
+					encoded += "," + value.String()
+				}
+			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []netip.Addr", typedJson.Value)
+		}
+	case IP_PREFIX_SLICE:
+		if values, ok := typedJson.Value.([]netip.Prefix); ok {
+			for index, value := range values {
+				if !value.IsValid() {
+					return "", fmt.Errorf("failed to cast '%v' to an ip prefix", value)
+				}
+
+				if index == 0 {
+					encoded = value.String()
+				} else {
+					encoded += "," + value.String()
+				}
+			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []netip.Prefix", typedJson.Value)
+		}
+	case ADDR_PORT_SLICE:
+		if values, ok := typedJson.Value.([]netip.AddrPort); ok {
+			for index, value := range values {
+				if !value.IsValid() {
+					return "", fmt.Errorf("failed to cast '%v' to an addr port", value)
+				}
+
+				if index == 0 {
+					encoded = value.String()
+				} else {
+					encoded += "," + value.String()
+				}
+			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []netip.AddrPort", typedJson.Value)
+		}
+	case MAC_SLICE:
+		if values, ok := typedJson.Value.([]net.HardwareAddr); ok {
+			for index, value := range values {
+				if len(value) == 0 {
+					return "", fmt.Errorf("failed to cast '%v' to a mac", value)
+				}
+
+				if index == 0 {
+					encoded = value.String()
+				} else {
+					encoded += "," + value.String()
+				}
+			}
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []net.HardwareAddr", typedJson.Value)
+		}
+	case URL:
+		if value, ok := typedJson.Value.(*url.URL); !ok || value == nil {
+			return "", fmt.Errorf("failed to cast '%v' to a url", typedJson.Value)
+		}
+
+		encoded = typedJson.Value.(*url.URL).String()
+	case BYTES, BYTES_URL, BYTES_RAW, BYTES_RAW_URL:
+		if values, ok := typedJson.Value.([]byte); ok {
+			encoded = bytesEncodings[typedJson.Type].EncodeToString(values)
+		} else {
+			return "", fmt.Errorf("failed to cast '%v' to a []byte", typedJson.Value)
+		}
+	default:
+		return "", fmt.Errorf("unknow type '%s' to encode", typedJson.Type)
+	}
+
+	return encoded, nil
+}
+
+func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
+	temp := &struct {
+		Type  JSONTYPE        `json:"Type"`
+		Value json.RawMessage `json:"Value"`
+	}{}
+
+	if err := json.Unmarshal(b, temp); err != nil {
+		return err
+	}
+
+	typedJson.Type = temp.Type
+
+	// null is decoded as a nil value for every type except JSON, which keeps the raw json as is
+	if string(temp.Value) == "null" && temp.Type != JSON {
+		typedJson.Value = nil
+		return nil
+	}
+
+	// try the custom codec types
+	if typedJson.customCodec != nil {
+		if encoder, ok := typedJson.customCodec[temp.Type]; ok {
+			value, err := unquote(b)
+			if err != nil {
+				return err
+			}
+
+			val, err := encoder.Decode(value)
+			if err != nil {
+				return err
+			}
+
+			typedJson.Value = val
+
+			return nil
+		}
+	}
+
+	// try the global codec types
+	if GlobalCodec != nil {
+		if encoder, ok := GlobalCodec[temp.Type]; ok {
+			value, err := unquote(b)
+			if err != nil {
+				return err
+			}
+
+			val, err := encoder.Decode(value)
+			if err != nil {
+				return err
+			}
+
+			typedJson.Value = val
+
+			return nil
+		}
+	}
+
+	// multi-dimensional slices are decoded from nested json arrays
+	if element, goType, ok := nestedSlice(temp.Type); ok {
+		if len(temp.Value) == 0 {
+			typedJson.Value = reflect.MakeSlice(goType, 0, 0).Interface()
+			return nil
+		}
+
+		values, err := decodeNestedSlice(element, goType, temp.Value)
+		if err != nil {
+			return err
+		}
+
+		typedJson.Value = values.Interface()
+		return nil
+	}
+
+	// nested types are decoded from json rather than a string
+	switch temp.Type {
+	case OBJECT:
+		objects := map[string]json.RawMessage{}
+		if len(temp.Value) != 0 {
+			if err := json.Unmarshal(temp.Value, &objects); err != nil {
+				return fmt.Errorf("failed to convert '%s' to an object", string(temp.Value))
+			}
+		}
+
+		values := make(map[string]*TypedJson, len(objects))
+		for key, object := range objects {
+			value, err := typedJson.unmarshalNested(object)
+			if err != nil {
+				return fmt.Errorf("failed to decode key '%s': %w", key, err)
+			}
+
+			values[key] = value
+		}
+
+		typedJson.Value = values
+	case ARRAY:
+		arrays := []json.RawMessage{}
+		if len(temp.Value) != 0 {
+			if err := json.Unmarshal(temp.Value, &arrays); err != nil {
+				return fmt.Errorf("failed to convert '%s' to an array", string(temp.Value))
 			}
 		}
-	case COMPLEX128_SLICE:
-		if typedJson.Value == nil {
-			temp.Value = ""
-		} else {
-			if values, ok := typedJson.Value.([]complex128); ok {
-				for index, value := range values {
-					if index == 0 {
-						temp.Value = strconv.FormatComplex(value, 'E', -1, 128)
-					} else {
-						temp.Value += "," + strconv.FormatComplex(value, 'E', -1, 128)
-					}
-				}
-			} else {
-				return nil, fmt.Errorf("failed to cast '%v' to a []complex128", typedJson.Value)
+
+		values := make([]*TypedJson, 0, len(arrays))
+		for index, array := range arrays {
+			value, err := typedJson.unmarshalNested(array)
+			if err != nil {
+				return fmt.Errorf("failed to decode index %d: %w", index, err)
 			}
+
+			values = append(values, value)
+		}
+
+		typedJson.Value = values
+	case JSON:
+		if len(temp.Value) == 0 {
+			return fmt.Errorf("failed to convert '' to json")
 		}
+
+		typedJson.Value = append(json.RawMessage{}, temp.Value...)
+	case STRING_INT_MAP, STRING_INT8_MAP, STRING_INT16_MAP, STRING_INT32_MAP, STRING_INT64_MAP,
+		STRING_UINT_MAP, STRING_UINT8_MAP, STRING_UINT16_MAP, STRING_UINT32_MAP, STRING_UINT64_MAP,
+		STRING_FLOAT32_MAP, STRING_FLOAT64_MAP, STRING_STRING_MAP, STRING_BOOL_MAP, STRING_DATETIME_MAP,
+		STRING_TIME_DURATION_MAP, STRING_COMPLEX64_MAP, STRING_COMPLEX128_MAP, STRING_BIG_INT_MAP, STRING_BIG_FLOAT_MAP,
+		STRING_BIG_RAT_MAP, STRING_INT128_MAP, STRING_UINT128_MAP, STRING_DECIMAL_MAP, STRING_DATE_MAP,
+		STRING_TIME_OF_DAY_MAP, STRING_UUID_MAP, STRING_IP_MAP, STRING_IP_PREFIX_MAP, STRING_ADDR_PORT_MAP,
+		STRING_MAC_MAP, STRING_URL_MAP:
+		return typedJson.decodeMap(temp.Value)
 	default:
-		return nil, fmt.Errorf("unknow type '%s' to encode", temp.Type)
+		// try the default codec types
+		value, err := unquote(b)
+		if err != nil {
+			return err
+		}
+
+		if err := typedJson.decodeString(value); err != nil {
+			return err
+		}
+
+		// NaN and ±Inf are decoded as nil when using the FLOAT_NULL policy
+		if GlobalFloatPolicy == FLOAT_NULL && typedJson.isNonFinite() {
+			typedJson.Value = nil
+		}
 	}
 
-	return json.Marshal(temp)
+	return nil
 }
 
-func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
-	temp := &struct {
-		Type  JSONTYPE `json:"Type"`
-		Value string   `json:"Value"`
-	}{}
+// unmarshalNested decodes a TypedJson that is stored inside of this TypedJson. The nested value inherits the
+// codec of its parent.
+func (typedJson *TypedJson) unmarshalNested(data json.RawMessage) (*TypedJson, error) {
+	if string(data) == "null" {
+		return nil, nil
+	}
 
-	if err := json.Unmarshal(b, temp); err != nil {
-		return err
+	nested := &TypedJson{customCodec: typedJson.customCodec}
+	if err := nested.UnmarshalJSON(data); err != nil {
+		// a child that is not a TypedJson is reported in the same format as the other decode errors. Errors from
+		// deeper children are already wrapped, so only the child's own json error is checked.
+		switch err.(type) {
+		case *json.UnmarshalTypeError, *json.SyntaxError:
+			return nil, fmt.Errorf("failed to convert '%s' to a TypedJson: %w", string(data), err)
+		default:
+			return nil, err
+		}
 	}
 
-	typedJson.Type = temp.Type
+	return nested, nil
+}
 
-	// try the custom codec types
-	if typedJson.customCodec != nil {
-		if encoder, ok := typedJson.customCodec[temp.Type]; ok {
-			val, err := encoder.Decode(temp.Value)
-			if err != nil {
-				return err
-			}
+// decodeMap decodes a json object of encoded strings into the exact map type for the string keyed map types
+func (typedJson *TypedJson) decodeMap(data json.RawMessage) error {
+	stringMap := stringMaps[typedJson.Type]
 
-			typedJson.Value = val
+	encoded := map[string]string{}
+	if len(data) != 0 {
+		if err := json.Unmarshal(data, &encoded); err != nil {
+			return fmt.Errorf("failed to convert '%s' to a %s", string(data), stringMap.goType)
+		}
+	}
 
-			return nil
+	values := reflect.MakeMapWithSize(stringMap.goType, len(encoded))
+	for key, value := range encoded {
+		element := &TypedJson{Type: stringMap.element}
+		if err := element.decodeString(value); err != nil {
+			return fmt.Errorf("failed to decode key '%s': %w", key, err)
 		}
+
+		values.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(element.Value))
 	}
 
-	// try the global codec types
-	if GlobalCodec != nil {
-		if encoder, ok := GlobalCodec[temp.Type]; ok {
-			val, err := encoder.Decode(temp.Value)
-			if err != nil {
-				return err
-			}
+	typedJson.Value = values.Interface()
+	return nil
+}
 
-			typedJson.Value = val
+// encodeNestedSlice encodes each dimension of a multi-dimensional slice as a json array, until the innermost
+// slices which are encoded as the 1 dimensional element type. Nil slices at any depth are encoded as null.
+func encodeNestedSlice(element JSONTYPE, values reflect.Value) (any, error) {
+	if values.IsNil() {
+		return nil, nil
+	}
 
-			return nil
+	if values.Type() == sliceTypes[element] {
+		return (&TypedJson{Type: element, Value: values.Interface()}).encodeString()
+	}
+
+	encoded := make([]any, 0, values.Len())
+	for index := range values.Len() {
+		value, err := encodeNestedSlice(element, values.Index(index))
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode index %d: %w", index, err)
 		}
+
+		encoded = append(encoded, value)
 	}
 
-	// try the default codec types
-	switch temp.Type {
+	return encoded, nil
+}
+
+// decodeNestedSlice decodes each json array of a multi-dimensional slice into the exact Go type, preserving nil
+// and empty slices at any depth
+func decodeNestedSlice(element JSONTYPE, goType reflect.Type, data json.RawMessage) (reflect.Value, error) {
+	if string(data) == "null" {
+		return reflect.Zero(goType), nil
+	}
+
+	if goType == sliceTypes[element] {
+		var encoded string
+		if err := json.Unmarshal(data, &encoded); err != nil {
+			return reflect.Value{}, fmt.Errorf("failed to convert '%s' to a %s", string(data), goType)
+		}
+
+		decoded := &TypedJson{Type: element}
+		if err := decoded.decodeString(encoded); err != nil {
+			return reflect.Value{}, err
+		}
+
+		return reflect.ValueOf(decoded.Value), nil
+	}
+
+	arrays := []json.RawMessage{}
+	if err := json.Unmarshal(data, &arrays); err != nil {
+		return reflect.Value{}, fmt.Errorf("failed to convert '%s' to a %s", string(data), goType)
+	}
+
+	values := reflect.MakeSlice(goType, 0, len(arrays))
+	for index, array := range arrays {
+		value, err := decodeNestedSlice(element, goType.Elem(), array)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("failed to decode index %d: %w", index, err)
+		}
+
+		values = reflect.Append(values, value)
+	}
+
+	return values, nil
+}
+
+// isNil reports if the value is nil or a typed nil such as a nil pointer, slice or map
+func isNil(value any) bool {
+	if value == nil {
+		return true
+	}
+
+	switch reflectValue := reflect.ValueOf(value); reflectValue.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface, reflect.Func, reflect.Chan:
+		return reflectValue.IsNil()
+	default:
+		return false
+	}
+}
+
+// unquote returns the string that all default and codec types are encoded as
+func unquote(b []byte) (string, error) {
+	temp := &struct {
+		Value string `json:"Value"`
+	}{}
+
+	if err := json.Unmarshal(b, temp); err != nil {
+		return "", err
+	}
+
+	return temp.Value, nil
+}
+
+// decodeString decodes all the default types that are represented as a single string
+func (typedJson *TypedJson) decodeString(encoded string) error {
+	switch typedJson.Type {
 	case INT:
-		val, err := strconv.ParseInt(temp.Value, 10, 0)
+		val, err := strconv.ParseInt(encoded, 10, 0)
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to an int", temp.Value)
+			return fmt.Errorf("failed to convert '%s' to an int", encoded)
 		}
 		typedJson.Value = int(val)
 	case INT8:
-		val, err := strconv.ParseInt(temp.Value, 10, 8)
+		val, err := strconv.ParseInt(encoded, 10, 8)
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to an int8", temp.Value)
+			return fmt.Errorf("failed to convert '%s' to an int8", encoded)
 		}
 		typedJson.Value = int8(val)
 	case INT16:
-		val, err := strconv.ParseInt(temp.Value, 10, 16)
+		val, err := strconv.ParseInt(encoded, 10, 16)
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to an int16", temp.Value)
+			return fmt.Errorf("failed to convert '%s' to an int16", encoded)
 		}
 		typedJson.Value = int16(val)
 	case INT32:
-		val, err := strconv.ParseInt(temp.Value, 10, 32)
+		val, err := strconv.ParseInt(encoded, 10, 32)
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to an int32", temp.Value)
+			return fmt.Errorf("failed to convert '%s' to an int32", encoded)
 		}
 		typedJson.Value = int32(val)
 	case INT64:
-		val, err := strconv.ParseInt(temp.Value, 10, 64)
+		val, err := strconv.ParseInt(encoded, 10, 64)
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to an int64", temp.Value)
+			return fmt.Errorf("failed to convert '%s' to an int64", encoded)
 		}
 		typedJson.Value = int64(val)
 	case UINT:
-		val, err := strconv.ParseUint(temp.Value, 10, 0)
+		val, err := strconv.ParseUint(encoded, 10, 0)
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to a uint", temp.Value)
+			return fmt.Errorf("failed to convert '%s' to a uint", encoded)
 		}
 		typedJson.Value = uint(val)
 	case UINT8:
-		val, err := strconv.ParseUint(temp.Value, 10, 8)
+		val, err := strconv.ParseUint(encoded, 10, 8)
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to a uint8", temp.Value)
+			return fmt.Errorf("failed to convert '%s' to a uint8", encoded)
 		}
 		typedJson.Value = uint8(val)
 	case UINT16:
-		val, err := strconv.ParseUint(temp.Value, 10, 16)
+		val, err := strconv.ParseUint(encoded, 10, 16)
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to a uint16", temp.Value)
+			return fmt.Errorf("failed to convert '%s' to a uint16", encoded)
 		}
 		typedJson.Value = uint16(val)
 	case UINT32:
-		val, err := strconv.ParseUint(temp.Value, 10, 32)
+		val, err := strconv.ParseUint(encoded, 10, 32)
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to a uint32", temp.Value)
+			return fmt.Errorf("failed to convert '%s' to a uint32", encoded)
 		}
 		typedJson.Value = uint32(val)
 	case UINT64:
-		val, err := strconv.ParseUint(temp.Value, 10, 64)
+		val, err := strconv.ParseUint(encoded, 10, 64)
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to a uint64", temp.Value)
+			return fmt.Errorf("failed to convert '%s' to a uint64", encoded)
 		}
 		typedJson.Value = uint64(val)
 	case FLOAT32:
-		val, err := strconv.ParseFloat(temp.Value, 32)
+		val, err := parseFloat(encoded, 32, "float32")
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to a float32", temp.Value)
+			return err
 		}
 		typedJson.Value = float32(val)
 	case FLOAT64:
-		val, err := strconv.ParseFloat(temp.Value, 64)
+		val, err := parseFloat(encoded, 64, "float64")
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to a float64", temp.Value)
+			return err
 		}
 		typedJson.Value = float64(val)
 	case STRING:
-		typedJson.Value = string(temp.Value)
+		typedJson.Value = string(encoded)
 	case DATETIME:
-		val, err := time.Parse(time.RFC3339, temp.Value)
+		val, err := parseDatetime(encoded)
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to a datetime", temp.Value)
+			return fmt.Errorf("failed to convert '%s' to a datetime", encoded)
 		}
 		typedJson.Value = val
 	case TIME_DURATION:
-		val, err := time.ParseDuration(temp.Value)
+		val, err := time.ParseDuration(encoded)
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to a time duration", temp.Value)
+			return fmt.Errorf("failed to convert '%s' to a time duration", encoded)
 		}
 		typedJson.Value = val
 	case BOOL:
-		val, err := strconv.ParseBool(temp.Value)
+		val, err := strconv.ParseBool(encoded)
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to a bool", temp.Value)
+			return fmt.Errorf("failed to convert '%s' to a bool", encoded)
 		}
 		typedJson.Value = bool(val)
 	case COMPLEX64:
-		val, err := strconv.ParseComplex(temp.Value, 64)
+		val, err := parseComplex(encoded, 64, "complex64")
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to a complex64", temp.Value)
+			return err
 		}
 		typedJson.Value = complex64(val)
 	case COMPLEX128:
-		val, err := strconv.ParseComplex(temp.Value, 128)
+		val, err := parseComplex(encoded, 128, "complex128")
 		if err != nil {
-			return fmt.Errorf("failed to convert '%s' to a complex128", temp.Value)
+			return err
 		}
 		typedJson.Value = val
 	case INT_SLICE:
 		tmp := []int{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
 				val, err := strconv.ParseInt(value, 10, 0)
 				if err != nil {
 					return fmt.Errorf("failed to convert '%s' to an int", value)
@@ -744,8 +1764,8 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case INT8_SLICE:
 		tmp := []int8{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
 				val, err := strconv.ParseInt(value, 10, 8)
 				if err != nil {
 					return fmt.Errorf("failed to convert '%s' to an int8", value)
@@ -758,8 +1778,8 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case INT16_SLICE:
 		tmp := []int16{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
 				val, err := strconv.ParseInt(value, 10, 16)
 				if err != nil {
 					return fmt.Errorf("failed to convert '%s' to an int16", value)
@@ -772,8 +1792,8 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case INT32_SLICE:
 		tmp := []int32{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
 				val, err := strconv.ParseInt(value, 10, 32)
 				if err != nil {
 					return fmt.Errorf("failed to convert '%s' to an int32", value)
@@ -786,9 +1806,9 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case INT64_SLICE:
 		tmp := []int64{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
-				val, err := strconv.ParseInt(value, 10, 8)
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, err := strconv.ParseInt(value, 10, 64)
 				if err != nil {
 					return fmt.Errorf("failed to convert '%s' to an int64", value)
 				}
@@ -800,8 +1820,8 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case UINT_SLICE:
 		tmp := []uint{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
 				val, err := strconv.ParseUint(value, 10, 0)
 				if err != nil {
 					return fmt.Errorf("failed to convert '%s' to a uint", value)
@@ -814,8 +1834,8 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case UINT8_SLICE:
 		tmp := []uint8{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
 				val, err := strconv.ParseUint(value, 10, 8)
 				if err != nil {
 					return fmt.Errorf("failed to convert '%s' to a uint8", value)
@@ -828,8 +1848,8 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case UINT16_SLICE:
 		tmp := []uint16{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
 				val, err := strconv.ParseUint(value, 10, 16)
 				if err != nil {
 					return fmt.Errorf("failed to convert '%s' to a uint16", value)
@@ -842,8 +1862,8 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case UINT32_SLICE:
 		tmp := []uint32{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
 				val, err := strconv.ParseUint(value, 10, 32)
 				if err != nil {
 					return fmt.Errorf("failed to convert '%s' to a uint32", value)
@@ -856,8 +1876,8 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case UINT64_SLICE:
 		tmp := []uint64{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
 				val, err := strconv.ParseUint(value, 10, 64)
 				if err != nil {
 					return fmt.Errorf("failed to convert '%s' to a uint64", value)
@@ -870,11 +1890,11 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case FLOAT32_SLICE:
 		tmp := []float32{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
-				val, err := strconv.ParseFloat(value, 32)
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, err := parseFloat(value, 32, "float32")
 				if err != nil {
-					return fmt.Errorf("failed to convert '%s' to a float32", value)
+					return err
 				}
 
 				tmp = append(tmp, float32(val))
@@ -884,11 +1904,11 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case FLOAT64_SLICE:
 		tmp := []float64{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
-				val, err := strconv.ParseFloat(value, 64)
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, err := parseFloat(value, 64, "float64")
 				if err != nil {
-					return fmt.Errorf("failed to convert '%s' to a float64", value)
+					return err
 				}
 
 				tmp = append(tmp, float64(val))
@@ -898,8 +1918,8 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case STRING_SLICE:
 		tmp := []string{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
 				decodedValue, err := base64.StdEncoding.DecodeString(value)
 				if err != nil {
 					return fmt.Errorf("string '%s' is not an expected base64", value)
@@ -912,8 +1932,8 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case BOOL_SLICE:
 		tmp := []bool{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
 				val, err := strconv.ParseBool(value)
 				if err != nil {
 					return fmt.Errorf("failed to convert '%s' to a bool", value)
@@ -926,9 +1946,9 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case DATETIME_SLICE:
 		tmp := []time.Time{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
-				val, err := time.Parse(time.RFC3339, value)
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, err := parseDatetime(value)
 				if err != nil {
 					return fmt.Errorf("failed to convert '%s' to a datetime", value)
 				}
@@ -942,8 +1962,8 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case TIME_DURATION_SLICE:
 		tmp := []time.Duration{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
 				val, err := time.ParseDuration(value)
 				if err != nil {
 					return fmt.Errorf("failed to convert '%s' to a duration", value)
@@ -958,11 +1978,11 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case COMPLEX64_SLICE:
 		tmp := []complex64{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
-				val, err := strconv.ParseComplex(value, 64)
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, err := parseComplex(value, 64, "complex64")
 				if err != nil {
-					return fmt.Errorf("failed to convert '%s' to a complex64", value)
+					return err
 				}
 
 				tmp = append(tmp, complex64(val))
@@ -972,11 +1992,257 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		typedJson.Value = tmp
 	case COMPLEX128_SLICE:
 		tmp := []complex128{}
-		if temp.Value != "" {
-			for _, value := range strings.Split(temp.Value, ",") {
-				val, err := strconv.ParseComplex(value, 128)
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, err := parseComplex(value, 128, "complex128")
+				if err != nil {
+					return err
+				}
+
+				tmp = append(tmp, val)
+			}
+		}
+
+		typedJson.Value = tmp
+	case BIG_INT:
+		val, ok := new(big.Int).SetString(encoded, 10)
+		if !ok {
+			return fmt.Errorf("failed to convert '%s' to a *big.Int", encoded)
+		}
+		typedJson.Value = val
+	case BIG_FLOAT:
+		val, err := parseBigFloat(encoded)
+		if err != nil {
+			return fmt.Errorf("failed to convert '%s' to a *big.Float", encoded)
+		}
+		typedJson.Value = val
+	case BIG_RAT:
+		val, ok := new(big.Rat).SetString(encoded)
+		if !ok {
+			return fmt.Errorf("failed to convert '%s' to a *big.Rat", encoded)
+		}
+		typedJson.Value = val
+	case BIG_INT_SLICE:
+		tmp := []*big.Int{}
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, ok := new(big.Int).SetString(value, 10)
+				if !ok {
+					return fmt.Errorf("failed to convert '%s' to a *big.Int", value)
+				}
+
+				tmp = append(tmp, val)
+			}
+		}
+
+		typedJson.Value = tmp
+	case BIG_FLOAT_SLICE:
+		tmp := []*big.Float{}
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, err := parseBigFloat(value)
+				if err != nil {
+					return fmt.Errorf("failed to convert '%s' to a *big.Float", value)
+				}
+
+				tmp = append(tmp, val)
+			}
+		}
+
+		typedJson.Value = tmp
+	case BIG_RAT_SLICE:
+		tmp := []*big.Rat{}
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, ok := new(big.Rat).SetString(value)
+				if !ok {
+					return fmt.Errorf("failed to convert '%s' to a *big.Rat", value)
+				}
+
+				tmp = append(tmp, val)
+			}
+		}
+
+		typedJson.Value = tmp
+	case INT128:
+		val, err := ParseInt128(encoded)
+		if err != nil {
+			return fmt.Errorf("failed to convert '%s' to an int128: %w", encoded, err)
+		}
+		typedJson.Value = val
+	case UINT128:
+		val, err := ParseUint128(encoded)
+		if err != nil {
+			return fmt.Errorf("failed to convert '%s' to a uint128: %w", encoded, err)
+		}
+		typedJson.Value = val
+	case INT128_SLICE:
+		tmp := []Int128{}
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, err := ParseInt128(value)
+				if err != nil {
+					return fmt.Errorf("failed to convert '%s' to an int128: %w", value, err)
+				}
+
+				tmp = append(tmp, val)
+			}
+		}
+
+		typedJson.Value = tmp
+	case UINT128_SLICE:
+		tmp := []Uint128{}
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, err := ParseUint128(value)
+				if err != nil {
+					return fmt.Errorf("failed to convert '%s' to a uint128: %w", value, err)
+				}
+
+				tmp = append(tmp, val)
+			}
+		}
+
+		typedJson.Value = tmp
+	case DECIMAL:
+		val, err := ParseDecimal(encoded)
+		if err != nil {
+			return fmt.Errorf("failed to convert '%s' to a decimal: %w", encoded, err)
+		}
+		typedJson.Value = val
+	case DATE:
+		val, err := ParseDate(encoded)
+		if err != nil {
+			return fmt.Errorf("failed to convert '%s' to a date", encoded)
+		}
+		typedJson.Value = val
+	case TIME_OF_DAY:
+		val, err := ParseTimeOfDay(encoded)
+		if err != nil {
+			return fmt.Errorf("failed to convert '%s' to a time of day", encoded)
+		}
+		typedJson.Value = val
+	case DATE_SLICE:
+		tmp := []Date{}
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, err := ParseDate(value)
+				if err != nil {
+					return fmt.Errorf("failed to convert '%s' to a date", value)
+				}
+
+				tmp = append(tmp, val)
+			}
+		}
+
+		typedJson.Value = tmp
+	case TIME_OF_DAY_SLICE:
+		tmp := []TimeOfDay{}
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, err := ParseTimeOfDay(value)
+				if err != nil {
+					return fmt.Errorf("failed to convert '%s' to a time of day", value)
+				}
+
+				tmp = append(tmp, val)
+			}
+		}
+
+		typedJson.Value = tmp
+	case UUID:
+		val, err := ParseUuid(encoded)
+		if err != nil {
+			return fmt.Errorf("failed to convert '%s' to a uuid: %w", encoded, err)
+		}
+		typedJson.Value = val
+	case UUID_SLICE:
+		tmp := []Uuid{}
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, err := ParseUuid(value)
+				if err != nil {
+					return fmt.Errorf("failed to convert '%s' to a uuid: %w", value, err)
+				}
+
+				tmp = append(tmp, val)
+			}
+		}
+
+		typedJson.Value = tmp
+	case IP:
+		val, err := netip.ParseAddr(encoded)
+		if err != nil {
+			return fmt.Errorf("failed to convert '%s' to an ip: %w", encoded, err)
+		}
+		typedJson.Value = val
+	case IP_PREFIX:
+		val, err := netip.ParsePrefix(encoded)
+		if err != nil {
+			return fmt.Errorf("failed to convert '%s' to an ip prefix: %w", encoded, err)
+		}
+		typedJson.Value = val
+	case ADDR_PORT:
+		val, err := netip.ParseAddrPort(encoded)
+		if err != nil {
+			return fmt.Errorf("failed to convert '%s' to an addr port: %w", encoded, err)
+		}
+		typedJson.Value = val
+	case MAC:
+		val, err := net.ParseMAC(encoded)
+		if err != nil {
+			return fmt.Errorf("failed to convert '%s' to a mac: %w", encoded, err)
+		}
+		typedJson.Value = val
+	case IP_SLICE:
+		tmp := []netip.Addr{}
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, err := netip.ParseAddr(value)
+				if err != nil {
+					return fmt.Errorf("failed to convert '%s' to an ip: %w", value, err)
+				}
+
+				tmp = append(tmp, val)
+			}
+		}
+
+		typedJson.Value = tmp
+	case IP_PREFIX_SLICE:
+		tmp := []netip.Prefix{}
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, err := netip.ParsePrefix(value)
+				if err != nil {
+					return fmt.Errorf("failed to convert '%s' to an ip prefix: %w", value, err)
+				}
+
+				tmp = append(tmp, val)
+			}
+		}
+
+		typedJson.Value = tmp
+	case ADDR_PORT_SLICE:
+		tmp := []netip.AddrPort{}
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, err := netip.ParseAddrPort(value)
+				if err != nil {
+					return fmt.Errorf("failed to convert '%s' to an addr port: %w", value, err)
+				}
+
+				tmp = append(tmp, val)
+			}
+		}
+
+		typedJson.Value = tmp
+	case MAC_SLICE:
+		tmp := []net.HardwareAddr{}
+		if encoded != "" {
+			for _, value := range strings.Split(encoded, ",") {
+				val, err := net.ParseMAC(value)
 				if err != nil {
-					return fmt.Errorf("failed to convert '%s' to a complex128", value)
+					return fmt.Errorf("failed to convert '%s' to a mac: %w", value, err)
 				}
 
 				tmp = append(tmp, val)
@@ -984,9 +2250,104 @@ func (typedJson *TypedJson) UnmarshalJSON(b []byte) error {
 		}
 
 		typedJson.Value = tmp
+	case URL:
+		val, err := url.Parse(encoded)
+		if err != nil {
+			return fmt.Errorf("failed to convert '%s' to a url: %w", encoded, err)
+		}
+		typedJson.Value = val
+	case BYTES, BYTES_URL, BYTES_RAW, BYTES_RAW_URL:
+		val, err := bytesEncodings[typedJson.Type].DecodeString(encoded)
+		if err != nil {
+			return fmt.Errorf("failed to convert '%s' to a []byte", encoded)
+		}
+		typedJson.Value = val
 	default:
-		return fmt.Errorf("unknown type '%s' to decode", temp.Type)
+		return fmt.Errorf("unknown type '%s' to decode", typedJson.Type)
 	}
 
 	return nil
 }
+
+// formatDatetime encodes a time.Time as an RFC3339 timestamp with nanoseconds. When the time has a named location
+// other than UTC or Local, the name is appended in brackets as described by RFC 9557. For example:
+// `2024-03-10T01:30:00.123456789-05:00[America/New_York]`
+func formatDatetime(value time.Time) string {
+	encoded := value.Format(time.RFC3339Nano)
+
+	switch name := value.Location().String(); name {
+	case "", "UTC", "Local":
+		return encoded
+	default:
+		return encoded + "[" + name + "]"
+	}
+}
+
+// parseDatetime decodes a time.Time that was encoded with formatDatetime. Older values that were encoded with
+// only second precision are also accepted.
+func parseDatetime(encoded string) (time.Time, error) {
+	timestamp, name := encoded, ""
+	if strings.HasSuffix(encoded, "]") {
+		index := strings.LastIndex(encoded, "[")
+		if index < 0 {
+			return time.Time{}, fmt.Errorf("missing '[' for the location name")
+		}
+
+		timestamp, name = encoded[:index], encoded[index+1:len(encoded)-1]
+	}
+
+	value, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil || name == "" {
+		return value, err
+	}
+
+	// use the named location when it is known and agrees with the encoded offset. Otherwise the location could have
+	// different rules on this machine, so fall back to a fixed zone that keeps both the name and the offset
+	_, offset := value.Zone()
+	if location, err := time.LoadLocation(name); err == nil {
+		if _, locationOffset := value.In(location).Zone(); locationOffset == offset {
+			return value.In(location), nil
+		}
+	}
+
+	return value.In(time.FixedZone(name, offset)), nil
+}
+
+// formatBigFloat encodes a big.Float as 'value:precision:rounding mode' so the decoded value behaves exactly the
+// same as the original for any further arithmetic.
+func formatBigFloat(value *big.Float) string {
+	return fmt.Sprintf("%s:%d:%s", value.Text('g', -1), value.Prec(), value.Mode())
+}
+
+// parseBigFloat decodes a big.Float that was encoded with formatBigFloat
+func parseBigFloat(encoded string) (*big.Float, error) {
+	parts := strings.Split(encoded, ":")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("expected 'value:precision:rounding mode'")
+	}
+
+	prec, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil || prec > big.MaxPrec {
+		return nil, fmt.Errorf("invalid precision '%s'", parts[1])
+	}
+
+	mode, found := big.ToNearestEven, false
+	for roundingMode := big.ToNearestEven; roundingMode <= big.ToPositiveInf; roundingMode++ {
+		if roundingMode.String() == parts[2] {
+			mode, found = roundingMode, true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("invalid rounding mode '%s'", parts[2])
+	}
+
+	// the shortest decimal representation is only guaranteed to round trip when rounding to the nearest value,
+	// so the original rounding mode is set after parsing
+	value, _, err := big.ParseFloat(parts[0], 10, uint(prec), big.ToNearestEven)
+	if err != nil {
+		return nil, err
+	}
+
+	return value.SetPrec(uint(prec)).SetMode(mode), nil
+}
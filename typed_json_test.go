@@ -3,7 +3,13 @@ package gotypedjson_test
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"net/netip"
+	"net/url"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -39,6 +45,105 @@ func Test_NewTypedJson(t *testing.T) {
 	})
 }
 
+func Test_NewInferredTypedJson(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("It returns an error for a nil value", func(t *testing.T) {
+		_, err := gotypedjson.NewInferredTypedJson(nil, nil)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("failed to infer the type of a nil value"))
+	})
+
+	t.Run("It returns an error for an unsupported type", func(t *testing.T) {
+		_, err := gotypedjson.NewInferredTypedJson(struct{}{}, nil)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("failed to infer the type of struct {}"))
+
+		_, err = gotypedjson.NewInferredTypedJson([][]struct{}{}, nil)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("failed to infer the type of [][]struct {}"))
+	})
+
+	t.Run("It infers the default types", func(t *testing.T) {
+		for value, expected := range map[any]gotypedjson.JSONTYPE{
+			int16(1):      gotypedjson.INT16,
+			"a":           gotypedjson.STRING,
+			time.Second:   gotypedjson.TIME_DURATION,
+			netip.Addr{}:  gotypedjson.IP,
+			complex64(1i): gotypedjson.COMPLEX64,
+		} {
+			tJson, err := gotypedjson.NewInferredTypedJson(value, nil)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tJson.Type).To(Equal(expected))
+			g.Expect(tJson.Value).To(Equal(value))
+		}
+	})
+
+	t.Run("It infers slices and maps", func(t *testing.T) {
+		tJson, err := gotypedjson.NewInferredTypedJson([]uint32{1}, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tJson.Type).To(Equal(gotypedjson.UINT32_SLICE))
+
+		tJson, err = gotypedjson.NewInferredTypedJson([]byte{1}, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tJson.Type).To(Equal(gotypedjson.BYTES))
+
+		tJson, err = gotypedjson.NewInferredTypedJson([][]string{{"a"}}, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tJson.Type).To(Equal(gotypedjson.SliceOf(gotypedjson.STRING_SLICE)))
+
+		tJson, err = gotypedjson.NewInferredTypedJson(map[string]float64{}, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tJson.Type).To(Equal(gotypedjson.STRING_FLOAT64_MAP))
+	})
+
+	t.Run("It infers types registered in a custom codec", func(t *testing.T) {
+		codec := gotypedjson.CustomCodec{"status": gotypedjson.NewEnumCodec(testStatusActive, testStatusInactive)}
+
+		tJson, err := gotypedjson.NewInferredTypedJson(testStatusActive, codec)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tJson.Type).To(Equal(gotypedjson.JSONTYPE("status")))
+
+		data, err := json.Marshal(tJson)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(data)).To(Equal(`{"Type":"status","Value":"active"}`))
+	})
+
+	t.Run("It overrides the default types with a custom codec", func(t *testing.T) {
+		codec := gotypedjson.CustomCodec{"strict_url": gotypedjson.NewUrlCodec(gotypedjson.UrlOptions{})}
+
+		tJson, err := gotypedjson.NewInferredTypedJson(&url.URL{}, codec)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tJson.Type).To(Equal(gotypedjson.JSONTYPE("strict_url")))
+	})
+
+	t.Run("It uses the custom codec before the global codec", func(t *testing.T) {
+		gotypedjson.GlobalCodec = gotypedjson.CustomCodec{"global_status": gotypedjson.NewEnumCodec(testStatusActive)}
+		defer func() { gotypedjson.GlobalCodec = nil }()
+
+		tJson, err := gotypedjson.NewInferredTypedJson(testStatusActive, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tJson.Type).To(Equal(gotypedjson.JSONTYPE("global_status")))
+
+		codec := gotypedjson.CustomCodec{"status": gotypedjson.NewEnumCodec(testStatusActive)}
+
+		tJson, err = gotypedjson.NewInferredTypedJson(testStatusActive, codec)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tJson.Type).To(Equal(gotypedjson.JSONTYPE("status")))
+	})
+
+	t.Run("It returns an error when the type is ambiguous", func(t *testing.T) {
+		codec := gotypedjson.CustomCodec{
+			"status":       gotypedjson.NewEnumCodec(testStatusActive),
+			"other_status": gotypedjson.NewEnumCodec(testStatusInactive),
+		}
+
+		_, err := gotypedjson.NewInferredTypedJson(testStatusActive, codec)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("type gotypedjson_test.testStatus is ambiguous between the types [other_status, status]"))
+	})
+}
+
 func Test_NewTypedJsonDecoder(t *testing.T) {
 	g := NewGomegaWithT(t)
 
@@ -687,7 +792,7 @@ func Test_DateTime(t *testing.T) {
 
 			data, err := json.Marshal(tDateTime)
 			g.Expect(err).ToNot(HaveOccurred())
-			g.Expect(string(data)).To(Equal(fmt.Sprintf(`{"Type":"_datetime","Value":"%s"}`, currentTime.Format(time.RFC3339))))
+			g.Expect(string(data)).To(Equal(fmt.Sprintf(`{"Type":"_datetime","Value":"%s"}`, currentTime.Format(time.RFC3339Nano))))
 		})
 	})
 
@@ -704,10 +809,65 @@ func Test_DateTime(t *testing.T) {
 			tDateTime := &gotypedjson.TypedJson{}
 			testTime := time.Now()
 
-			err := json.Unmarshal([]byte(fmt.Sprintf(`{"Type":"_datetime","Value":"%s"}`, testTime.Format(time.RFC3339))), tDateTime)
+			err := json.Unmarshal([]byte(fmt.Sprintf(`{"Type":"_datetime","Value":"%s"}`, testTime.Format(time.RFC3339Nano))), tDateTime)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tDateTime.Type).To(Equal(gotypedjson.DATETIME))
+			g.Expect(tDateTime.Value.(time.Time).Equal(testTime)).To(BeTrue())
+		})
+
+		t.Run("It can decode second precision values", func(t *testing.T) {
+			tDateTime := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_datetime","Value":"2024-01-02T03:04:05-07:00"}`), tDateTime)
 			g.Expect(err).ToNot(HaveOccurred())
 			g.Expect(tDateTime.Type).To(Equal(gotypedjson.DATETIME))
-			g.Expect(tDateTime.Value.(time.Time).Format(time.RFC3339)).To(Equal(testTime.Format(time.RFC3339)))
+			g.Expect(tDateTime.Value.(time.Time).Equal(time.Date(2024, 1, 2, 10, 4, 5, 0, time.UTC))).To(BeTrue())
+		})
+	})
+
+	t.Run("Round trips", func(t *testing.T) {
+		t.Run("It preserves nanoseconds", func(t *testing.T) {
+			testTime := time.Now()
+
+			data, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.DATETIME, Value: testTime})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			tDateTime := &gotypedjson.TypedJson{}
+			g.Expect(json.Unmarshal(data, tDateTime)).ToNot(HaveOccurred())
+			g.Expect(tDateTime.Value.(time.Time).Equal(testTime)).To(BeTrue())
+		})
+
+		t.Run("It preserves the location name", func(t *testing.T) {
+			location, err := time.LoadLocation("America/New_York")
+			if err != nil {
+				t.Skip("time zone database is not available")
+			}
+			testTime := time.Date(2024, 7, 4, 12, 30, 0, 5, location)
+
+			data, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.DATETIME, Value: testTime})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_datetime","Value":"2024-07-04T12:30:00.000000005-04:00[America/New_York]"}`))
+
+			tDateTime := &gotypedjson.TypedJson{}
+			g.Expect(json.Unmarshal(data, tDateTime)).ToNot(HaveOccurred())
+			g.Expect(tDateTime.Value.(time.Time).Equal(testTime)).To(BeTrue())
+			g.Expect(tDateTime.Value.(time.Time).Location()).To(Equal(location))
+		})
+
+		t.Run("It preserves fixed zones", func(t *testing.T) {
+			testTime := time.Date(2024, 7, 4, 12, 30, 0, 0, time.FixedZone("Custom", 90*60))
+
+			data, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.DATETIME, Value: testTime})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_datetime","Value":"2024-07-04T12:30:00+01:30[Custom]"}`))
+
+			tDateTime := &gotypedjson.TypedJson{}
+			g.Expect(json.Unmarshal(data, tDateTime)).ToNot(HaveOccurred())
+			g.Expect(tDateTime.Value.(time.Time).Equal(testTime)).To(BeTrue())
+
+			name, offset := tDateTime.Value.(time.Time).Zone()
+			g.Expect(name).To(Equal("Custom"))
+			g.Expect(offset).To(Equal(90 * 60))
 		})
 	})
 }
@@ -837,6 +997,16 @@ func Test_Complex128(t *testing.T) {
 			g.Expect(tComplex128.Type).To(Equal(gotypedjson.COMPLEX128))
 			g.Expect(tComplex128.Value.(complex128)).To(Equal(complex(1, -3)))
 		})
+
+		t.Run("It round trips values with full float64 precision", func(t *testing.T) {
+			data, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.COMPLEX128, Value: complex(0.123456789012345, 1)})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_complex128","Value":"(1.23456789012345E-01+1E+00i)"}`))
+
+			tComplex128 := &gotypedjson.TypedJson{}
+			g.Expect(json.Unmarshal(data, tComplex128)).ToNot(HaveOccurred())
+			g.Expect(tComplex128.Value.(complex128)).To(Equal(complex(0.123456789012345, 1)))
+		})
 	})
 }
 
@@ -857,7 +1027,7 @@ func Test_Int_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tInt := &gotypedjson.TypedJson{Type: gotypedjson.INT_SLICE, Value: nil}
+			tInt := &gotypedjson.TypedJson{Type: gotypedjson.INT_SLICE, Value: []int{}}
 
 			data, err := json.Marshal(tInt)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -936,7 +1106,7 @@ func Test_Int8_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tInt8S := &gotypedjson.TypedJson{Type: gotypedjson.INT8_SLICE, Value: nil}
+			tInt8S := &gotypedjson.TypedJson{Type: gotypedjson.INT8_SLICE, Value: []int8{}}
 
 			data, err := json.Marshal(tInt8S)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -1015,7 +1185,7 @@ func Test_Int16_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tInt16S := &gotypedjson.TypedJson{Type: gotypedjson.INT16_SLICE, Value: nil}
+			tInt16S := &gotypedjson.TypedJson{Type: gotypedjson.INT16_SLICE, Value: []int16{}}
 
 			data, err := json.Marshal(tInt16S)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -1094,7 +1264,7 @@ func Test_Int32_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tInt32S := &gotypedjson.TypedJson{Type: gotypedjson.INT32_SLICE, Value: nil}
+			tInt32S := &gotypedjson.TypedJson{Type: gotypedjson.INT32_SLICE, Value: []int32{}}
 
 			data, err := json.Marshal(tInt32S)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -1173,7 +1343,7 @@ func Test_Int64_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tInt64S := &gotypedjson.TypedJson{Type: gotypedjson.INT64_SLICE, Value: nil}
+			tInt64S := &gotypedjson.TypedJson{Type: gotypedjson.INT64_SLICE, Value: []int64{}}
 
 			data, err := json.Marshal(tInt64S)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -1252,7 +1422,7 @@ func Test_Uint_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tUintS := &gotypedjson.TypedJson{Type: gotypedjson.UINT_SLICE, Value: nil}
+			tUintS := &gotypedjson.TypedJson{Type: gotypedjson.UINT_SLICE, Value: []uint{}}
 
 			data, err := json.Marshal(tUintS)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -1331,7 +1501,7 @@ func Test_Uint8_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tUint8S := &gotypedjson.TypedJson{Type: gotypedjson.UINT8_SLICE, Value: nil}
+			tUint8S := &gotypedjson.TypedJson{Type: gotypedjson.UINT8_SLICE, Value: []uint8{}}
 
 			data, err := json.Marshal(tUint8S)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -1410,7 +1580,7 @@ func Test_Uint16_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tUint8S := &gotypedjson.TypedJson{Type: gotypedjson.UINT16_SLICE, Value: nil}
+			tUint8S := &gotypedjson.TypedJson{Type: gotypedjson.UINT16_SLICE, Value: []uint16{}}
 
 			data, err := json.Marshal(tUint8S)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -1489,7 +1659,7 @@ func Test_Uint32_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tUint32S := &gotypedjson.TypedJson{Type: gotypedjson.UINT32_SLICE, Value: nil}
+			tUint32S := &gotypedjson.TypedJson{Type: gotypedjson.UINT32_SLICE, Value: []uint32{}}
 
 			data, err := json.Marshal(tUint32S)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -1568,7 +1738,7 @@ func Test_Uint64_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tUint64S := &gotypedjson.TypedJson{Type: gotypedjson.UINT64_SLICE, Value: nil}
+			tUint64S := &gotypedjson.TypedJson{Type: gotypedjson.UINT64_SLICE, Value: []uint64{}}
 
 			data, err := json.Marshal(tUint64S)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -1647,7 +1817,7 @@ func Test_Float32_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tFloat32S := &gotypedjson.TypedJson{Type: gotypedjson.FLOAT32_SLICE, Value: nil}
+			tFloat32S := &gotypedjson.TypedJson{Type: gotypedjson.FLOAT32_SLICE, Value: []float32{}}
 
 			data, err := json.Marshal(tFloat32S)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -1726,7 +1896,7 @@ func Test_Float64_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tFloat64S := &gotypedjson.TypedJson{Type: gotypedjson.FLOAT64_SLICE, Value: nil}
+			tFloat64S := &gotypedjson.TypedJson{Type: gotypedjson.FLOAT64_SLICE, Value: []float64{}}
 
 			data, err := json.Marshal(tFloat64S)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -1805,7 +1975,7 @@ func Test_String_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tStringS := &gotypedjson.TypedJson{Type: gotypedjson.STRING_SLICE, Value: nil}
+			tStringS := &gotypedjson.TypedJson{Type: gotypedjson.STRING_SLICE, Value: []string{}}
 
 			data, err := json.Marshal(tStringS)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -1884,7 +2054,7 @@ func Test_Bool_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tBoolS := &gotypedjson.TypedJson{Type: gotypedjson.BOOL_SLICE, Value: nil}
+			tBoolS := &gotypedjson.TypedJson{Type: gotypedjson.BOOL_SLICE, Value: []bool{}}
 
 			data, err := json.Marshal(tBoolS)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -1953,8 +2123,8 @@ func Test_DateTime_Slice(t *testing.T) {
 	timeTwo := time.Now()
 
 	rawDataEmpty := `{"Type":"_datetime_array","Value":""}`
-	rawDataSingle := fmt.Sprintf(`{"Type":"_datetime_array","Value":"%s"}`, timeOne.Format(time.RFC3339))
-	rawDataMulti := fmt.Sprintf(`{"Type":"_datetime_array","Value":"%s,%s"}`, timeOne.Format(time.RFC3339), timeTwo.Format(time.RFC3339))
+	rawDataSingle := fmt.Sprintf(`{"Type":"_datetime_array","Value":"%s"}`, timeOne.Format(time.RFC3339Nano))
+	rawDataMulti := fmt.Sprintf(`{"Type":"_datetime_array","Value":"%s,%s"}`, timeOne.Format(time.RFC3339Nano), timeTwo.Format(time.RFC3339Nano))
 
 	t.Run("Encoding", func(t *testing.T) {
 		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
@@ -1967,7 +2137,7 @@ func Test_DateTime_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tDateTimeS := &gotypedjson.TypedJson{Type: gotypedjson.DATETIME_SLICE, Value: nil}
+			tDateTimeS := &gotypedjson.TypedJson{Type: gotypedjson.DATETIME_SLICE, Value: []time.Time{}}
 
 			data, err := json.Marshal(tDateTimeS)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -2016,7 +2186,7 @@ func Test_DateTime_Slice(t *testing.T) {
 			g.Expect(err).ToNot(HaveOccurred())
 			g.Expect(tDateTimeS.Type).To(Equal(gotypedjson.DATETIME_SLICE))
 			g.Expect(len(tDateTimeS.Value.(([]time.Time)))).To(Equal(1))
-			g.Expect(tDateTimeS.Value.([]time.Time)[0].Format(time.RFC3339)).To(Equal(timeOne.Format(time.RFC3339)))
+			g.Expect(tDateTimeS.Value.([]time.Time)[0].Equal(timeOne)).To(BeTrue())
 		})
 
 		t.Run("It can decode multiple values properly", func(t *testing.T) {
@@ -2026,8 +2196,8 @@ func Test_DateTime_Slice(t *testing.T) {
 			g.Expect(err).ToNot(HaveOccurred())
 			g.Expect(tDateTimeS.Type).To(Equal(gotypedjson.DATETIME_SLICE))
 			g.Expect(len(tDateTimeS.Value.(([]time.Time)))).To(Equal(2))
-			g.Expect(tDateTimeS.Value.([]time.Time)[0].Format(time.RFC3339)).To(Equal(timeOne.Format(time.RFC3339)))
-			g.Expect(tDateTimeS.Value.([]time.Time)[1].Format(time.RFC3339)).To(Equal(timeTwo.Format(time.RFC3339)))
+			g.Expect(tDateTimeS.Value.([]time.Time)[0].Equal(timeOne)).To(BeTrue())
+			g.Expect(tDateTimeS.Value.([]time.Time)[1].Equal(timeTwo)).To(BeTrue())
 		})
 	})
 }
@@ -2050,7 +2220,7 @@ func Test_Duration_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tDurationS := &gotypedjson.TypedJson{Type: gotypedjson.TIME_DURATION_SLICE, Value: nil}
+			tDurationS := &gotypedjson.TypedJson{Type: gotypedjson.TIME_DURATION_SLICE, Value: []time.Duration{}}
 
 			data, err := json.Marshal(tDurationS)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -2129,7 +2299,7 @@ func Test_Complex64_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tComplex64S := &gotypedjson.TypedJson{Type: gotypedjson.COMPLEX64_SLICE, Value: nil}
+			tComplex64S := &gotypedjson.TypedJson{Type: gotypedjson.COMPLEX64_SLICE, Value: []complex64{}}
 
 			data, err := json.Marshal(tComplex64S)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -2208,7 +2378,7 @@ func Test_Complex128_Slice(t *testing.T) {
 		})
 
 		t.Run("It can encode an empty slice", func(t *testing.T) {
-			tComplex128S := &gotypedjson.TypedJson{Type: gotypedjson.COMPLEX128_SLICE, Value: nil}
+			tComplex128S := &gotypedjson.TypedJson{Type: gotypedjson.COMPLEX128_SLICE, Value: []complex128{}}
 
 			data, err := json.Marshal(tComplex128S)
 			g.Expect(err).ToNot(HaveOccurred())
@@ -2270,6 +2440,2257 @@ func Test_Complex128_Slice(t *testing.T) {
 	})
 }
 
+func Test_StringMap(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_string_int64_map","Value":{}}`
+	rawDataMulti := `{"Type":"_string_int64_map","Value":{"a":"-1","b":"2","c":"9223372036854775807"}}`
+	testMap := map[string]int64{"c": 9223372036854775807, "a": -1, "b": 2}
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tMap := &gotypedjson.TypedJson{Type: gotypedjson.STRING_INT64_MAP, Value: map[string]int{"a": 1}}
+			data, err := json.Marshal(tMap)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'map[a:1]' to a map[string]int64"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty map", func(t *testing.T) {
+			tMap := &gotypedjson.TypedJson{Type: gotypedjson.STRING_INT64_MAP, Value: map[string]int64{}}
+
+			data, err := json.Marshal(tMap)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It encodes the keys in sorted order", func(t *testing.T) {
+			tMap := &gotypedjson.TypedJson{Type: gotypedjson.STRING_INT64_MAP, Value: testMap}
+
+			for i := 0; i < 10; i++ {
+				data, err := json.Marshal(tMap)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(string(data)).To(Equal(rawDataMulti))
+			}
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			tMap := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_string_int64_map","Value":"a:1"}`), tMap)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`failed to convert '"a:1"' to a map[string]int64`))
+		})
+
+		t.Run("It fails to decode an invalid element", func(t *testing.T) {
+			tMap := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_string_int64_map","Value":{"a":"1","b":"two"}}`), tMap)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to decode key 'b': failed to convert 'two' to an int64"))
+		})
+
+		t.Run("It can decode an empty map", func(t *testing.T) {
+			tMap := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tMap)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tMap.Type).To(Equal(gotypedjson.STRING_INT64_MAP))
+			g.Expect(tMap.Value.(map[string]int64)).To(Equal(map[string]int64{}))
+		})
+
+		t.Run("It can decode multiple values properly", func(t *testing.T) {
+			tMap := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataMulti), tMap)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tMap.Type).To(Equal(gotypedjson.STRING_INT64_MAP))
+			g.Expect(tMap.Value.(map[string]int64)).To(Equal(testMap))
+		})
+	})
+
+	t.Run("It round trips every value type", func(t *testing.T) {
+		testDatetime := time.Date(2024, 3, 10, 1, 30, 0, 123, time.UTC)
+		testInt128, _ := gotypedjson.ParseInt128("-170141183460469231731687303715884105728")
+		testUint128, _ := gotypedjson.ParseUint128("340282366920938463463374607431768211455")
+		testDate, _ := gotypedjson.ParseDate("2024-02-29")
+		testTimeOfDay, _ := gotypedjson.ParseTimeOfDay("23:59:59.5")
+		testUuid, _ := gotypedjson.ParseUuid("123e4567-e89b-12d3-a456-426614174000")
+		testMaps := map[gotypedjson.JSONTYPE]any{
+			gotypedjson.STRING_INT_MAP:           map[string]int{"one": 1},
+			gotypedjson.STRING_INT8_MAP:          map[string]int8{"one": -8},
+			gotypedjson.STRING_INT16_MAP:         map[string]int16{"one": -16},
+			gotypedjson.STRING_INT32_MAP:         map[string]int32{"one": -32},
+			gotypedjson.STRING_INT64_MAP:         map[string]int64{"one": -64},
+			gotypedjson.STRING_UINT_MAP:          map[string]uint{"one": 1},
+			gotypedjson.STRING_UINT8_MAP:         map[string]uint8{"one": 8},
+			gotypedjson.STRING_UINT16_MAP:        map[string]uint16{"one": 16},
+			gotypedjson.STRING_UINT32_MAP:        map[string]uint32{"one": 32},
+			gotypedjson.STRING_UINT64_MAP:        map[string]uint64{"one": 64},
+			gotypedjson.STRING_FLOAT32_MAP:       map[string]float32{"one": 3.2},
+			gotypedjson.STRING_FLOAT64_MAP:       map[string]float64{"one": 6.4},
+			gotypedjson.STRING_STRING_MAP:        map[string]string{"one": "a,b:\"c\"", "": ""},
+			gotypedjson.STRING_BOOL_MAP:          map[string]bool{"one": true, "two": false},
+			gotypedjson.STRING_DATETIME_MAP:      map[string]time.Time{"one": testDatetime},
+			gotypedjson.STRING_TIME_DURATION_MAP: map[string]time.Duration{"one": time.Minute},
+			gotypedjson.STRING_COMPLEX64_MAP:     map[string]complex64{"one": complex(1, -2)},
+			gotypedjson.STRING_COMPLEX128_MAP:    map[string]complex128{"one": complex(-1, 2)},
+			gotypedjson.STRING_BIG_INT_MAP:       map[string]*big.Int{"one": big.NewInt(-1000)},
+			gotypedjson.STRING_BIG_RAT_MAP:       map[string]*big.Rat{"one": big.NewRat(1, 3)},
+			gotypedjson.STRING_INT128_MAP:        map[string]gotypedjson.Int128{"one": testInt128},
+			gotypedjson.STRING_UINT128_MAP:       map[string]gotypedjson.Uint128{"one": testUint128},
+			gotypedjson.STRING_DECIMAL_MAP:       map[string]gotypedjson.Decimal{"one": gotypedjson.NewDecimal(-1050, 2)},
+			gotypedjson.STRING_DATE_MAP:          map[string]gotypedjson.Date{"one": testDate},
+			gotypedjson.STRING_TIME_OF_DAY_MAP:   map[string]gotypedjson.TimeOfDay{"one": testTimeOfDay},
+			gotypedjson.STRING_UUID_MAP:          map[string]gotypedjson.Uuid{"one": testUuid},
+			gotypedjson.STRING_IP_MAP:            map[string]netip.Addr{"one": netip.MustParseAddr("::1")},
+			gotypedjson.STRING_IP_PREFIX_MAP:     map[string]netip.Prefix{"one": netip.MustParsePrefix("10.0.0.0/8")},
+			gotypedjson.STRING_ADDR_PORT_MAP:     map[string]netip.AddrPort{"one": netip.MustParseAddrPort("127.0.0.1:80")},
+			gotypedjson.STRING_MAC_MAP:           map[string]net.HardwareAddr{"one": {0, 1, 2, 3, 4, 5}},
+			gotypedjson.STRING_URL_MAP:           map[string]*url.URL{"one": {Scheme: "https", Host: "example.com", Path: "/a"}},
+		}
+
+		for jsonType, testMap := range testMaps {
+			data, err := json.Marshal(&gotypedjson.TypedJson{Type: jsonType, Value: testMap})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			tMap := &gotypedjson.TypedJson{}
+			g.Expect(json.Unmarshal(data, tMap)).ToNot(HaveOccurred())
+			g.Expect(tMap.Type).To(Equal(jsonType))
+			g.Expect(tMap.Value).To(Equal(testMap))
+		}
+	})
+
+	t.Run("It round trips big floats", func(t *testing.T) {
+		data, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.STRING_BIG_FLOAT_MAP, Value: map[string]*big.Float{"one": big.NewFloat(1.5)}})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		tMap := &gotypedjson.TypedJson{}
+		g.Expect(json.Unmarshal(data, tMap)).ToNot(HaveOccurred())
+		g.Expect(tMap.Value.(map[string]*big.Float)["one"].Cmp(big.NewFloat(1.5))).To(Equal(0))
+	})
+}
+
+func Test_Nested_Slice(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_int32_array_array","Value":[]}`
+	rawDataMulti := `{"Type":"_int32_array_array","Value":["1,2,3",null,"","-4"]}`
+	testSlices := [][]int32{{1, 2, 3}, nil, {}, {-4}}
+
+	t.Run("It creates the type for a slice of slices", func(t *testing.T) {
+		g.Expect(gotypedjson.SliceOf(gotypedjson.INT32_SLICE)).To(Equal(gotypedjson.JSONTYPE("_int32_array_array")))
+		g.Expect(gotypedjson.SliceOf(gotypedjson.SliceOf(gotypedjson.FLOAT64_SLICE))).To(Equal(gotypedjson.JSONTYPE("_float64_array_array_array")))
+	})
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tSlices := &gotypedjson.TypedJson{Type: gotypedjson.SliceOf(gotypedjson.INT32_SLICE), Value: []int32{1}}
+			data, err := json.Marshal(tSlices)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast '[1]' to a [][]int32"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty slice", func(t *testing.T) {
+			tSlices := &gotypedjson.TypedJson{Type: gotypedjson.SliceOf(gotypedjson.INT32_SLICE), Value: [][]int32{}}
+
+			data, err := json.Marshal(tSlices)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It can encode nil and empty inner slices", func(t *testing.T) {
+			tSlices := &gotypedjson.TypedJson{Type: gotypedjson.SliceOf(gotypedjson.INT32_SLICE), Value: testSlices}
+
+			data, err := json.Marshal(tSlices)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataMulti))
+		})
+
+		t.Run("It can encode an arbitrary depth", func(t *testing.T) {
+			tSlices := &gotypedjson.TypedJson{
+				Type:  gotypedjson.SliceOf(gotypedjson.SliceOf(gotypedjson.FLOAT64_SLICE)),
+				Value: [][][]float64{{{1.5, 2}, nil}, nil, {}},
+			}
+
+			data, err := json.Marshal(tSlices)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_float64_array_array_array","Value":[["1.5E+00,2E+00",null],null,[]]}`))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			tSlices := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_int32_array_array","Value":"1,2"}`), tSlices)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`failed to convert '"1,2"' to a [][]int32`))
+		})
+
+		t.Run("It fails to decode an invalid element", func(t *testing.T) {
+			tSlices := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_int32_array_array_array","Value":[["1"],["2","three"]]}`), tSlices)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to decode index 1: failed to decode index 1: failed to convert 'three' to an int32"))
+		})
+
+		t.Run("It can decode an empty slice", func(t *testing.T) {
+			tSlices := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tSlices)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tSlices.Type).To(Equal(gotypedjson.SliceOf(gotypedjson.INT32_SLICE)))
+			g.Expect(tSlices.Value.([][]int32)).To(Equal([][]int32{}))
+		})
+
+		t.Run("It preserves nil and empty inner slices", func(t *testing.T) {
+			tSlices := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataMulti), tSlices)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tSlices.Value.([][]int32)).To(Equal(testSlices))
+			g.Expect(tSlices.Value.([][]int32)[1]).To(BeNil())
+			g.Expect(tSlices.Value.([][]int32)[2]).ToNot(BeNil())
+		})
+
+		t.Run("It can decode an arbitrary depth", func(t *testing.T) {
+			tSlices := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_string_array_array_array","Value":[["aGVsbG8=,",null],null,[]]}`), tSlices)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tSlices.Value.([][][]string)).To(Equal([][][]string{{{"hello", ""}, nil}, nil, {}}))
+		})
+
+		t.Run("It round trips int64 values outside of the int8 range", func(t *testing.T) {
+			data, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.SliceOf(gotypedjson.INT64_SLICE), Value: [][]int64{{1000, math.MinInt64}, {math.MaxInt64}}})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			tSlices := &gotypedjson.TypedJson{}
+			g.Expect(json.Unmarshal(data, tSlices)).ToNot(HaveOccurred())
+			g.Expect(tSlices.Value.([][]int64)).To(Equal([][]int64{{1000, math.MinInt64}, {math.MaxInt64}}))
+		})
+	})
+}
+
+func Test_BigInt(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawData := `{"Type":"_bigint","Value":"-123456789012345678901234567890"}`
+	testInt, _ := new(big.Int).SetString("-123456789012345678901234567890", 10)
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tBigInt := &gotypedjson.TypedJson{Type: gotypedjson.BIG_INT, Value: "nope"}
+			data, err := json.Marshal(tBigInt)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a *big.Int"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode the value properly", func(t *testing.T) {
+			tBigInt := &gotypedjson.TypedJson{Type: gotypedjson.BIG_INT, Value: testInt}
+
+			data, err := json.Marshal(tBigInt)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			tBigInt := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_bigint","Value":"1.5"}`), tBigInt)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert '1.5' to a *big.Int"))
+		})
+
+		t.Run("It can decode the value properly", func(t *testing.T) {
+			tBigInt := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tBigInt)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tBigInt.Type).To(Equal(gotypedjson.BIG_INT))
+			g.Expect(tBigInt.Value.(*big.Int).Cmp(testInt)).To(Equal(0))
+		})
+	})
+}
+
+func Test_BigFloat(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawData := `{"Type":"_bigfloat","Value":"1.5:53:ToNearestEven"}`
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tBigFloat := &gotypedjson.TypedJson{Type: gotypedjson.BIG_FLOAT, Value: "nope"}
+			data, err := json.Marshal(tBigFloat)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a *big.Float"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode the value properly", func(t *testing.T) {
+			tBigFloat := &gotypedjson.TypedJson{Type: gotypedjson.BIG_FLOAT, Value: big.NewFloat(1.5)}
+
+			data, err := json.Marshal(tBigFloat)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			for _, value := range []string{"nope", "1.5:nope:ToZero", "1.5:53:nope", "nope:53:ToZero"} {
+				tBigFloat := &gotypedjson.TypedJson{}
+
+				err := json.Unmarshal([]byte(fmt.Sprintf(`{"Type":"_bigfloat","Value":"%s"}`, value)), tBigFloat)
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(Equal(fmt.Sprintf("failed to convert '%s' to a *big.Float", value)))
+			}
+		})
+
+		t.Run("It can decode the value properly", func(t *testing.T) {
+			tBigFloat := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tBigFloat)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tBigFloat.Type).To(Equal(gotypedjson.BIG_FLOAT))
+			g.Expect(tBigFloat.Value.(*big.Float).Cmp(big.NewFloat(1.5))).To(Equal(0))
+		})
+
+		t.Run("It preserves the precision and rounding mode", func(t *testing.T) {
+			third := new(big.Float).SetPrec(200).SetMode(big.ToZero).Quo(big.NewFloat(1), big.NewFloat(3))
+
+			data, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.BIG_FLOAT, Value: third})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			tBigFloat := &gotypedjson.TypedJson{}
+			g.Expect(json.Unmarshal(data, tBigFloat)).ToNot(HaveOccurred())
+			g.Expect(tBigFloat.Value.(*big.Float).Cmp(third)).To(Equal(0))
+			g.Expect(tBigFloat.Value.(*big.Float).Prec()).To(Equal(uint(200)))
+			g.Expect(tBigFloat.Value.(*big.Float).Mode()).To(Equal(big.ToZero))
+		})
+	})
+}
+
+func Test_BigRat(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawData := `{"Type":"_bigrat","Value":"-1/3"}`
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tBigRat := &gotypedjson.TypedJson{Type: gotypedjson.BIG_RAT, Value: "nope"}
+			data, err := json.Marshal(tBigRat)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a *big.Rat"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode the value properly", func(t *testing.T) {
+			tBigRat := &gotypedjson.TypedJson{Type: gotypedjson.BIG_RAT, Value: big.NewRat(-1, 3)}
+
+			data, err := json.Marshal(tBigRat)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			tBigRat := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_bigrat","Value":"1/0"}`), tBigRat)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert '1/0' to a *big.Rat"))
+		})
+
+		t.Run("It can decode the value properly", func(t *testing.T) {
+			tBigRat := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tBigRat)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tBigRat.Type).To(Equal(gotypedjson.BIG_RAT))
+			g.Expect(tBigRat.Value.(*big.Rat).Cmp(big.NewRat(-1, 3))).To(Equal(0))
+		})
+	})
+}
+
+func Test_BigInt_Slice(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_bigint_array","Value":""}`
+	rawDataMulti := `{"Type":"_bigint_array","Value":"1,-18446744073709551616"}`
+	testInt, _ := new(big.Int).SetString("-18446744073709551616", 10)
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tBigIntS := &gotypedjson.TypedJson{Type: gotypedjson.BIG_INT_SLICE, Value: "nope"}
+			data, err := json.Marshal(tBigIntS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a []*big.Int"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty slice", func(t *testing.T) {
+			tBigIntS := &gotypedjson.TypedJson{Type: gotypedjson.BIG_INT_SLICE, Value: []*big.Int{}}
+
+			data, err := json.Marshal(tBigIntS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It can encode multiple value properly", func(t *testing.T) {
+			tBigIntS := &gotypedjson.TypedJson{Type: gotypedjson.BIG_INT_SLICE, Value: []*big.Int{big.NewInt(1), testInt}}
+
+			data, err := json.Marshal(tBigIntS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataMulti))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an invalid value", func(t *testing.T) {
+			tBigIntS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_bigint_array","Value":"1,hello"}`), tBigIntS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert 'hello' to a *big.Int"))
+		})
+
+		t.Run("It can decode an empty slice", func(t *testing.T) {
+			tBigIntS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tBigIntS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tBigIntS.Type).To(Equal(gotypedjson.BIG_INT_SLICE))
+			g.Expect(tBigIntS.Value.([]*big.Int)).To(Equal([]*big.Int{}))
+		})
+
+		t.Run("It can decode multiple values properly", func(t *testing.T) {
+			tBigIntS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataMulti), tBigIntS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tBigIntS.Type).To(Equal(gotypedjson.BIG_INT_SLICE))
+			g.Expect(tBigIntS.Value.([]*big.Int)).To(HaveLen(2))
+			g.Expect(tBigIntS.Value.([]*big.Int)[0].Cmp(big.NewInt(1))).To(Equal(0))
+			g.Expect(tBigIntS.Value.([]*big.Int)[1].Cmp(testInt)).To(Equal(0))
+		})
+	})
+}
+
+func Test_BigFloat_Slice(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_bigfloat_array","Value":""}`
+	rawDataMulti := `{"Type":"_bigfloat_array","Value":"1.5:53:ToNearestEven,-2.25:10:AwayFromZero"}`
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tBigFloatS := &gotypedjson.TypedJson{Type: gotypedjson.BIG_FLOAT_SLICE, Value: "nope"}
+			data, err := json.Marshal(tBigFloatS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a []*big.Float"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty slice", func(t *testing.T) {
+			tBigFloatS := &gotypedjson.TypedJson{Type: gotypedjson.BIG_FLOAT_SLICE, Value: []*big.Float{}}
+
+			data, err := json.Marshal(tBigFloatS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It can encode multiple value properly", func(t *testing.T) {
+			tBigFloatS := &gotypedjson.TypedJson{Type: gotypedjson.BIG_FLOAT_SLICE, Value: []*big.Float{
+				big.NewFloat(1.5),
+				new(big.Float).SetPrec(10).SetMode(big.AwayFromZero).SetFloat64(-2.25),
+			}}
+
+			data, err := json.Marshal(tBigFloatS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataMulti))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an invalid value", func(t *testing.T) {
+			tBigFloatS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_bigfloat_array","Value":"1.5:53:ToNearestEven,hello"}`), tBigFloatS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert 'hello' to a *big.Float"))
+		})
+
+		t.Run("It can decode an empty slice", func(t *testing.T) {
+			tBigFloatS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tBigFloatS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tBigFloatS.Type).To(Equal(gotypedjson.BIG_FLOAT_SLICE))
+			g.Expect(tBigFloatS.Value.([]*big.Float)).To(Equal([]*big.Float{}))
+		})
+
+		t.Run("It can decode multiple values properly", func(t *testing.T) {
+			tBigFloatS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataMulti), tBigFloatS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tBigFloatS.Type).To(Equal(gotypedjson.BIG_FLOAT_SLICE))
+
+			values := tBigFloatS.Value.([]*big.Float)
+			g.Expect(values).To(HaveLen(2))
+			g.Expect(values[0].Cmp(big.NewFloat(1.5))).To(Equal(0))
+			g.Expect(values[1].Cmp(big.NewFloat(-2.25))).To(Equal(0))
+			g.Expect(values[1].Prec()).To(Equal(uint(10)))
+			g.Expect(values[1].Mode()).To(Equal(big.AwayFromZero))
+		})
+	})
+}
+
+func Test_BigRat_Slice(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_bigrat_array","Value":""}`
+	rawDataMulti := `{"Type":"_bigrat_array","Value":"1/2,-5/1"}`
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tBigRatS := &gotypedjson.TypedJson{Type: gotypedjson.BIG_RAT_SLICE, Value: "nope"}
+			data, err := json.Marshal(tBigRatS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a []*big.Rat"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty slice", func(t *testing.T) {
+			tBigRatS := &gotypedjson.TypedJson{Type: gotypedjson.BIG_RAT_SLICE, Value: []*big.Rat{}}
+
+			data, err := json.Marshal(tBigRatS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It can encode multiple value properly", func(t *testing.T) {
+			tBigRatS := &gotypedjson.TypedJson{Type: gotypedjson.BIG_RAT_SLICE, Value: []*big.Rat{big.NewRat(1, 2), big.NewRat(-5, 1)}}
+
+			data, err := json.Marshal(tBigRatS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataMulti))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an invalid value", func(t *testing.T) {
+			tBigRatS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_bigrat_array","Value":"1/2,hello"}`), tBigRatS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert 'hello' to a *big.Rat"))
+		})
+
+		t.Run("It can decode an empty slice", func(t *testing.T) {
+			tBigRatS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tBigRatS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tBigRatS.Type).To(Equal(gotypedjson.BIG_RAT_SLICE))
+			g.Expect(tBigRatS.Value.([]*big.Rat)).To(Equal([]*big.Rat{}))
+		})
+
+		t.Run("It can decode multiple values properly", func(t *testing.T) {
+			tBigRatS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataMulti), tBigRatS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tBigRatS.Type).To(Equal(gotypedjson.BIG_RAT_SLICE))
+			g.Expect(tBigRatS.Value.([]*big.Rat)).To(HaveLen(2))
+			g.Expect(tBigRatS.Value.([]*big.Rat)[0].Cmp(big.NewRat(1, 2))).To(Equal(0))
+			g.Expect(tBigRatS.Value.([]*big.Rat)[1].Cmp(big.NewRat(-5, 1))).To(Equal(0))
+		})
+	})
+}
+
+func Test_Int128(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawData := `{"Type":"_int128","Value":"-170141183460469231731687303715884105728"}`
+	testInt128 := gotypedjson.MinInt128
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tInt128 := &gotypedjson.TypedJson{Type: gotypedjson.INT128, Value: 5}
+			data, err := json.Marshal(tInt128)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast '5' to an int128"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode the value properly", func(t *testing.T) {
+			tInt128 := &gotypedjson.TypedJson{Type: gotypedjson.INT128, Value: testInt128}
+
+			data, err := json.Marshal(tInt128)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode a value that overflows", func(t *testing.T) {
+			tInt128 := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_int128","Value":"170141183460469231731687303715884105728"}`), tInt128)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert '170141183460469231731687303715884105728' to an int128: '170141183460469231731687303715884105728' overflows an int128"))
+		})
+
+		t.Run("It can decode the value properly", func(t *testing.T) {
+			tInt128 := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tInt128)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tInt128.Type).To(Equal(gotypedjson.INT128))
+			g.Expect(tInt128.Value.(gotypedjson.Int128)).To(Equal(testInt128))
+		})
+	})
+}
+
+func Test_Uint128(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawData := `{"Type":"_uint128","Value":"340282366920938463463374607431768211455"}`
+	testUint128 := gotypedjson.MaxUint128
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tUint128 := &gotypedjson.TypedJson{Type: gotypedjson.UINT128, Value: 5}
+			data, err := json.Marshal(tUint128)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast '5' to a uint128"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode the value properly", func(t *testing.T) {
+			tUint128 := &gotypedjson.TypedJson{Type: gotypedjson.UINT128, Value: testUint128}
+
+			data, err := json.Marshal(tUint128)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode a value that overflows", func(t *testing.T) {
+			tUint128 := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_uint128","Value":"340282366920938463463374607431768211456"}`), tUint128)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert '340282366920938463463374607431768211456' to a uint128: '340282366920938463463374607431768211456' overflows a uint128"))
+		})
+
+		t.Run("It can decode the value properly", func(t *testing.T) {
+			tUint128 := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tUint128)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tUint128.Type).To(Equal(gotypedjson.UINT128))
+			g.Expect(tUint128.Value.(gotypedjson.Uint128)).To(Equal(testUint128))
+		})
+	})
+}
+
+func Test_Int128_Slice(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_int128_array","Value":""}`
+	rawDataMulti := `{"Type":"_int128_array","Value":"-1,0,18446744073709551616"}`
+	testInt128S := []gotypedjson.Int128{gotypedjson.Int128From64(-1), {}, {Hi: 1}}
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tInt128S := &gotypedjson.TypedJson{Type: gotypedjson.INT128_SLICE, Value: []int{5}}
+			data, err := json.Marshal(tInt128S)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast '[5]' to a []int128"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty slice", func(t *testing.T) {
+			tInt128S := &gotypedjson.TypedJson{Type: gotypedjson.INT128_SLICE, Value: []gotypedjson.Int128{}}
+
+			data, err := json.Marshal(tInt128S)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It can encode multiple value properly", func(t *testing.T) {
+			tInt128S := &gotypedjson.TypedJson{Type: gotypedjson.INT128_SLICE, Value: testInt128S}
+
+			data, err := json.Marshal(tInt128S)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataMulti))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an invalid value", func(t *testing.T) {
+			tInt128S := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_int128_array","Value":"1,one"}`), tInt128S)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert 'one' to an int128: 'one' is not a base 10 integer"))
+		})
+
+		t.Run("It can decode an empty slice", func(t *testing.T) {
+			tInt128S := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tInt128S)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tInt128S.Type).To(Equal(gotypedjson.INT128_SLICE))
+			g.Expect(tInt128S.Value.([]gotypedjson.Int128)).To(Equal([]gotypedjson.Int128{}))
+		})
+
+		t.Run("It can decode multiple values properly", func(t *testing.T) {
+			tInt128S := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataMulti), tInt128S)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tInt128S.Type).To(Equal(gotypedjson.INT128_SLICE))
+			g.Expect(tInt128S.Value.([]gotypedjson.Int128)).To(Equal(testInt128S))
+		})
+	})
+}
+
+func Test_Uint128_Slice(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_uint128_array","Value":""}`
+	rawDataMulti := `{"Type":"_uint128_array","Value":"1,18446744073709551616"}`
+	testUint128S := []gotypedjson.Uint128{gotypedjson.Uint128From64(1), {Hi: 1}}
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tUint128S := &gotypedjson.TypedJson{Type: gotypedjson.UINT128_SLICE, Value: []int{5}}
+			data, err := json.Marshal(tUint128S)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast '[5]' to a []uint128"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty slice", func(t *testing.T) {
+			tUint128S := &gotypedjson.TypedJson{Type: gotypedjson.UINT128_SLICE, Value: []gotypedjson.Uint128{}}
+
+			data, err := json.Marshal(tUint128S)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It can encode multiple value properly", func(t *testing.T) {
+			tUint128S := &gotypedjson.TypedJson{Type: gotypedjson.UINT128_SLICE, Value: testUint128S}
+
+			data, err := json.Marshal(tUint128S)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataMulti))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an invalid value", func(t *testing.T) {
+			tUint128S := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_uint128_array","Value":"1,-1"}`), tUint128S)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert '-1' to a uint128: '-1' overflows a uint128"))
+		})
+
+		t.Run("It can decode an empty slice", func(t *testing.T) {
+			tUint128S := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tUint128S)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tUint128S.Type).To(Equal(gotypedjson.UINT128_SLICE))
+			g.Expect(tUint128S.Value.([]gotypedjson.Uint128)).To(Equal([]gotypedjson.Uint128{}))
+		})
+
+		t.Run("It can decode multiple values properly", func(t *testing.T) {
+			tUint128S := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataMulti), tUint128S)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tUint128S.Type).To(Equal(gotypedjson.UINT128_SLICE))
+			g.Expect(tUint128S.Value.([]gotypedjson.Uint128)).To(Equal(testUint128S))
+		})
+	})
+}
+
+func Test_Decimal(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawData := `{"Type":"_decimal","Value":"10.50"}`
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tDecimal := &gotypedjson.TypedJson{Type: gotypedjson.DECIMAL, Value: "nope"}
+			data, err := json.Marshal(tDecimal)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a decimal"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode the value properly", func(t *testing.T) {
+			tDecimal := &gotypedjson.TypedJson{Type: gotypedjson.DECIMAL, Value: gotypedjson.NewDecimal(1050, 2)}
+
+			data, err := json.Marshal(tDecimal)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			tDecimal := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_decimal","Value":"10.5O"}`), tDecimal)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert '10.5O' to a decimal: unexpected character 'O' at index 4"))
+		})
+
+		t.Run("It can decode the value properly", func(t *testing.T) {
+			tDecimal := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tDecimal)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tDecimal.Type).To(Equal(gotypedjson.DECIMAL))
+			g.Expect(tDecimal.Value.(gotypedjson.Decimal).String()).To(Equal("10.50"))
+			g.Expect(tDecimal.Value.(gotypedjson.Decimal).Scale()).To(Equal(uint32(2)))
+		})
+	})
+}
+
+func Test_Date(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawData := `{"Type":"_date","Value":"2024-02-29"}`
+	testDate := gotypedjson.Date{Year: 2024, Month: time.February, Day: 29}
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tDate := &gotypedjson.TypedJson{Type: gotypedjson.DATE, Value: time.Now()}
+			data, err := json.Marshal(tDate)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(HavePrefix("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It returns an error if the date is invalid", func(t *testing.T) {
+			tDate := &gotypedjson.TypedJson{Type: gotypedjson.DATE, Value: gotypedjson.Date{Year: 2023, Month: time.February, Day: 29}}
+			data, err := json.Marshal(tDate)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast '2023-02-29' to a date"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode the value properly", func(t *testing.T) {
+			tDate := &gotypedjson.TypedJson{Type: gotypedjson.DATE, Value: testDate}
+
+			data, err := json.Marshal(tDate)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			tDate := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_date","Value":"2024-02-29T00:00:00Z"}`), tDate)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert '2024-02-29T00:00:00Z' to a date"))
+		})
+
+		t.Run("It can decode the value properly", func(t *testing.T) {
+			tDate := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tDate)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tDate.Type).To(Equal(gotypedjson.DATE))
+			g.Expect(tDate.Value.(gotypedjson.Date)).To(Equal(testDate))
+		})
+	})
+}
+
+func Test_TimeOfDay(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawData := `{"Type":"_time_of_day","Value":"17:30:05.25"}`
+	testTimeOfDay := gotypedjson.TimeOfDay{Hour: 17, Minute: 30, Second: 5, Nanosecond: 250000000}
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tTimeOfDay := &gotypedjson.TypedJson{Type: gotypedjson.TIME_OF_DAY, Value: "nope"}
+			data, err := json.Marshal(tTimeOfDay)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a time of day"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode the value properly", func(t *testing.T) {
+			tTimeOfDay := &gotypedjson.TypedJson{Type: gotypedjson.TIME_OF_DAY, Value: testTimeOfDay}
+
+			data, err := json.Marshal(tTimeOfDay)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			tTimeOfDay := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_time_of_day","Value":"25:00:00"}`), tTimeOfDay)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert '25:00:00' to a time of day"))
+		})
+
+		t.Run("It can decode the value properly", func(t *testing.T) {
+			tTimeOfDay := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tTimeOfDay)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tTimeOfDay.Type).To(Equal(gotypedjson.TIME_OF_DAY))
+			g.Expect(tTimeOfDay.Value.(gotypedjson.TimeOfDay)).To(Equal(testTimeOfDay))
+		})
+	})
+}
+
+func Test_Date_Slice(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_date_array","Value":""}`
+	rawDataMulti := `{"Type":"_date_array","Value":"2024-01-31,1999-12-01"}`
+	testDates := []gotypedjson.Date{{Year: 2024, Month: time.January, Day: 31}, {Year: 1999, Month: time.December, Day: 1}}
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tDateS := &gotypedjson.TypedJson{Type: gotypedjson.DATE_SLICE, Value: "nope"}
+			data, err := json.Marshal(tDateS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a []date"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty slice", func(t *testing.T) {
+			tDateS := &gotypedjson.TypedJson{Type: gotypedjson.DATE_SLICE, Value: []gotypedjson.Date{}}
+
+			data, err := json.Marshal(tDateS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It can encode multiple value properly", func(t *testing.T) {
+			tDateS := &gotypedjson.TypedJson{Type: gotypedjson.DATE_SLICE, Value: testDates}
+
+			data, err := json.Marshal(tDateS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataMulti))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an invalid value", func(t *testing.T) {
+			tDateS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_date_array","Value":"2024-01-31,hello"}`), tDateS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert 'hello' to a date"))
+		})
+
+		t.Run("It can decode an empty slice", func(t *testing.T) {
+			tDateS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tDateS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tDateS.Type).To(Equal(gotypedjson.DATE_SLICE))
+			g.Expect(tDateS.Value.([]gotypedjson.Date)).To(Equal([]gotypedjson.Date{}))
+		})
+
+		t.Run("It can decode multiple values properly", func(t *testing.T) {
+			tDateS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataMulti), tDateS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tDateS.Type).To(Equal(gotypedjson.DATE_SLICE))
+			g.Expect(tDateS.Value.([]gotypedjson.Date)).To(Equal(testDates))
+		})
+	})
+}
+
+func Test_TimeOfDay_Slice(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_time_of_day_array","Value":""}`
+	rawDataMulti := `{"Type":"_time_of_day_array","Value":"09:00:00,23:59:59.999999999"}`
+	testTimes := []gotypedjson.TimeOfDay{{Hour: 9}, {Hour: 23, Minute: 59, Second: 59, Nanosecond: 999999999}}
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tTimeOfDayS := &gotypedjson.TypedJson{Type: gotypedjson.TIME_OF_DAY_SLICE, Value: "nope"}
+			data, err := json.Marshal(tTimeOfDayS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a []time of day"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty slice", func(t *testing.T) {
+			tTimeOfDayS := &gotypedjson.TypedJson{Type: gotypedjson.TIME_OF_DAY_SLICE, Value: []gotypedjson.TimeOfDay{}}
+
+			data, err := json.Marshal(tTimeOfDayS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It can encode multiple value properly", func(t *testing.T) {
+			tTimeOfDayS := &gotypedjson.TypedJson{Type: gotypedjson.TIME_OF_DAY_SLICE, Value: testTimes}
+
+			data, err := json.Marshal(tTimeOfDayS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataMulti))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an invalid value", func(t *testing.T) {
+			tTimeOfDayS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_time_of_day_array","Value":"09:00:00,hello"}`), tTimeOfDayS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert 'hello' to a time of day"))
+		})
+
+		t.Run("It can decode an empty slice", func(t *testing.T) {
+			tTimeOfDayS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tTimeOfDayS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tTimeOfDayS.Type).To(Equal(gotypedjson.TIME_OF_DAY_SLICE))
+			g.Expect(tTimeOfDayS.Value.([]gotypedjson.TimeOfDay)).To(Equal([]gotypedjson.TimeOfDay{}))
+		})
+
+		t.Run("It can decode multiple values properly", func(t *testing.T) {
+			tTimeOfDayS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataMulti), tTimeOfDayS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tTimeOfDayS.Type).To(Equal(gotypedjson.TIME_OF_DAY_SLICE))
+			g.Expect(tTimeOfDayS.Value.([]gotypedjson.TimeOfDay)).To(Equal(testTimes))
+		})
+	})
+}
+
+func Test_Uuid(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawData := `{"Type":"_uuid","Value":"f47ac10b-58cc-4372-a567-0e02b2c3d479"}`
+	testUuid := gotypedjson.Uuid{0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72, 0xa5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79}
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tUuid := &gotypedjson.TypedJson{Type: gotypedjson.UUID, Value: "f47ac10b-58cc-4372-a567-0e02b2c3d479"}
+			data, err := json.Marshal(tUuid)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'f47ac10b-58cc-4372-a567-0e02b2c3d479' to a uuid"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode the value properly", func(t *testing.T) {
+			tUuid := &gotypedjson.TypedJson{Type: gotypedjson.UUID, Value: testUuid}
+
+			data, err := json.Marshal(tUuid)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			tUuid := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_uuid","Value":"nope"}`), tUuid)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert 'nope' to a uuid: uuid 'nope' must be 36 characters"))
+		})
+
+		t.Run("It can decode the value properly", func(t *testing.T) {
+			tUuid := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tUuid)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tUuid.Type).To(Equal(gotypedjson.UUID))
+			g.Expect(tUuid.Value.(gotypedjson.Uuid)).To(Equal(testUuid))
+		})
+
+		t.Run("It always re-encodes as lowercase", func(t *testing.T) {
+			tUuid := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_uuid","Value":"F47AC10B-58CC-4372-A567-0E02B2C3D479"}`), tUuid)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			data, err := json.Marshal(tUuid)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+	})
+}
+
+func Test_Uuid_Slice(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_uuid_array","Value":""}`
+	rawDataMulti := `{"Type":"_uuid_array","Value":"f47ac10b-58cc-4372-a567-0e02b2c3d479,00000000-0000-0000-0000-000000000000"}`
+	testUuid := gotypedjson.Uuid{0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72, 0xa5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79}
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tUuidS := &gotypedjson.TypedJson{Type: gotypedjson.UUID_SLICE, Value: "nope"}
+			data, err := json.Marshal(tUuidS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a []uuid"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty slice", func(t *testing.T) {
+			tUuidS := &gotypedjson.TypedJson{Type: gotypedjson.UUID_SLICE, Value: []gotypedjson.Uuid{}}
+
+			data, err := json.Marshal(tUuidS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It can encode multiple value properly", func(t *testing.T) {
+			tUuidS := &gotypedjson.TypedJson{Type: gotypedjson.UUID_SLICE, Value: []gotypedjson.Uuid{testUuid, gotypedjson.NilUuid}}
+
+			data, err := json.Marshal(tUuidS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataMulti))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an invalid value", func(t *testing.T) {
+			tUuidS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_uuid_array","Value":"f47ac10b-58cc-4372-a567-0e02b2c3d479,hello"}`), tUuidS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert 'hello' to a uuid: uuid 'hello' must be 36 characters"))
+		})
+
+		t.Run("It can decode an empty slice", func(t *testing.T) {
+			tUuidS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tUuidS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tUuidS.Type).To(Equal(gotypedjson.UUID_SLICE))
+			g.Expect(tUuidS.Value.([]gotypedjson.Uuid)).To(Equal([]gotypedjson.Uuid{}))
+		})
+
+		t.Run("It can decode multiple values properly", func(t *testing.T) {
+			tUuidS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataMulti), tUuidS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tUuidS.Type).To(Equal(gotypedjson.UUID_SLICE))
+			g.Expect(tUuidS.Value.([]gotypedjson.Uuid)).To(Equal([]gotypedjson.Uuid{testUuid, gotypedjson.NilUuid}))
+		})
+	})
+}
+
+func Test_Ip(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawData := `{"Type":"_ip","Value":"2001:db8::1"}`
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tIp := &gotypedjson.TypedJson{Type: gotypedjson.IP, Value: "nope"}
+			data, err := json.Marshal(tIp)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to an ip"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It returns an error if the value is invalid", func(t *testing.T) {
+			tIp := &gotypedjson.TypedJson{Type: gotypedjson.IP, Value: netip.Addr{}}
+			data, err := json.Marshal(tIp)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode the value properly", func(t *testing.T) {
+			tIp := &gotypedjson.TypedJson{Type: gotypedjson.IP, Value: netip.MustParseAddr("2001:db8::1")}
+
+			data, err := json.Marshal(tIp)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			tIp := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_ip","Value":"nope"}`), tIp)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`failed to convert 'nope' to an ip: ParseAddr("nope"): unable to parse IP`))
+		})
+
+		t.Run("It can decode the value properly", func(t *testing.T) {
+			tIp := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tIp)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tIp.Type).To(Equal(gotypedjson.IP))
+			g.Expect(tIp.Value.(netip.Addr)).To(Equal(netip.MustParseAddr("2001:db8::1")))
+		})
+	})
+}
+
+func Test_IpPrefix(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawData := `{"Type":"_ip_prefix","Value":"10.0.0.0/8"}`
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tIpPrefix := &gotypedjson.TypedJson{Type: gotypedjson.IP_PREFIX, Value: "nope"}
+			data, err := json.Marshal(tIpPrefix)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to an ip prefix"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It returns an error if the value is invalid", func(t *testing.T) {
+			tIpPrefix := &gotypedjson.TypedJson{Type: gotypedjson.IP_PREFIX, Value: netip.Prefix{}}
+			data, err := json.Marshal(tIpPrefix)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode the value properly", func(t *testing.T) {
+			tIpPrefix := &gotypedjson.TypedJson{Type: gotypedjson.IP_PREFIX, Value: netip.MustParsePrefix("10.0.0.0/8")}
+
+			data, err := json.Marshal(tIpPrefix)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			tIpPrefix := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_ip_prefix","Value":"10.0.0.0/33"}`), tIpPrefix)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`failed to convert '10.0.0.0/33' to an ip prefix: netip.ParsePrefix("10.0.0.0/33"): prefix length out of range`))
+		})
+
+		t.Run("It can decode the value properly", func(t *testing.T) {
+			tIpPrefix := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tIpPrefix)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tIpPrefix.Type).To(Equal(gotypedjson.IP_PREFIX))
+			g.Expect(tIpPrefix.Value.(netip.Prefix)).To(Equal(netip.MustParsePrefix("10.0.0.0/8")))
+		})
+	})
+}
+
+func Test_AddrPort(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawData := `{"Type":"_addr_port","Value":"[::1]:8080"}`
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tAddrPort := &gotypedjson.TypedJson{Type: gotypedjson.ADDR_PORT, Value: "nope"}
+			data, err := json.Marshal(tAddrPort)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to an addr port"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It returns an error if the value is invalid", func(t *testing.T) {
+			tAddrPort := &gotypedjson.TypedJson{Type: gotypedjson.ADDR_PORT, Value: netip.AddrPort{}}
+			data, err := json.Marshal(tAddrPort)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode the value properly", func(t *testing.T) {
+			tAddrPort := &gotypedjson.TypedJson{Type: gotypedjson.ADDR_PORT, Value: netip.MustParseAddrPort("[::1]:8080")}
+
+			data, err := json.Marshal(tAddrPort)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			tAddrPort := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_addr_port","Value":"10.0.0.1"}`), tAddrPort)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`failed to convert '10.0.0.1' to an addr port: not an ip:port`))
+		})
+
+		t.Run("It can decode the value properly", func(t *testing.T) {
+			tAddrPort := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tAddrPort)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tAddrPort.Type).To(Equal(gotypedjson.ADDR_PORT))
+			g.Expect(tAddrPort.Value.(netip.AddrPort)).To(Equal(netip.MustParseAddrPort("[::1]:8080")))
+		})
+	})
+}
+
+func Test_Mac(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawData := `{"Type":"_mac","Value":"00:1b:63:84:45:e6"}`
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tMac := &gotypedjson.TypedJson{Type: gotypedjson.MAC, Value: "nope"}
+			data, err := json.Marshal(tMac)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a mac"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It returns an error if the value is invalid", func(t *testing.T) {
+			tMac := &gotypedjson.TypedJson{Type: gotypedjson.MAC, Value: net.HardwareAddr{}}
+			data, err := json.Marshal(tMac)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode the value properly", func(t *testing.T) {
+			tMac := &gotypedjson.TypedJson{Type: gotypedjson.MAC, Value: net.HardwareAddr{0x00, 0x1b, 0x63, 0x84, 0x45, 0xe6}}
+
+			data, err := json.Marshal(tMac)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			tMac := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_mac","Value":"nope"}`), tMac)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`failed to convert 'nope' to a mac: address nope: invalid MAC address`))
+		})
+
+		t.Run("It can decode the value properly", func(t *testing.T) {
+			tMac := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tMac)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tMac.Type).To(Equal(gotypedjson.MAC))
+			g.Expect(tMac.Value.(net.HardwareAddr)).To(Equal(net.HardwareAddr{0x00, 0x1b, 0x63, 0x84, 0x45, 0xe6}))
+		})
+	})
+}
+
+func Test_Ip_Slice(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_ip_array","Value":""}`
+	rawDataMulti := `{"Type":"_ip_array","Value":"10.0.0.1,fe80::1%eth0"}`
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tIpS := &gotypedjson.TypedJson{Type: gotypedjson.IP_SLICE, Value: "nope"}
+			data, err := json.Marshal(tIpS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a []netip.Addr"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It returns an error if a value is invalid", func(t *testing.T) {
+			tIpS := &gotypedjson.TypedJson{Type: gotypedjson.IP_SLICE, Value: []netip.Addr{{}}}
+			data, err := json.Marshal(tIpS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty slice", func(t *testing.T) {
+			tIpS := &gotypedjson.TypedJson{Type: gotypedjson.IP_SLICE, Value: []netip.Addr{}}
+
+			data, err := json.Marshal(tIpS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It can encode multiple value properly", func(t *testing.T) {
+			tIpS := &gotypedjson.TypedJson{Type: gotypedjson.IP_SLICE, Value: []netip.Addr{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("fe80::1%eth0")}}
+
+			data, err := json.Marshal(tIpS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataMulti))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an invalid value", func(t *testing.T) {
+			tIpS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_ip_array","Value":"hello"}`), tIpS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`failed to convert 'hello' to an ip: ParseAddr("hello"): unable to parse IP`))
+		})
+
+		t.Run("It can decode an empty slice", func(t *testing.T) {
+			tIpS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tIpS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tIpS.Type).To(Equal(gotypedjson.IP_SLICE))
+			g.Expect(tIpS.Value.([]netip.Addr)).To(Equal([]netip.Addr{}))
+		})
+
+		t.Run("It can decode multiple values properly", func(t *testing.T) {
+			tIpS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataMulti), tIpS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tIpS.Type).To(Equal(gotypedjson.IP_SLICE))
+			g.Expect(tIpS.Value.([]netip.Addr)).To(Equal([]netip.Addr{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("fe80::1%eth0")}))
+		})
+	})
+}
+
+func Test_IpPrefix_Slice(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_ip_prefix_array","Value":""}`
+	rawDataMulti := `{"Type":"_ip_prefix_array","Value":"10.0.0.0/8,2001:db8::/32"}`
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tIpPrefixS := &gotypedjson.TypedJson{Type: gotypedjson.IP_PREFIX_SLICE, Value: "nope"}
+			data, err := json.Marshal(tIpPrefixS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a []netip.Prefix"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It returns an error if a value is invalid", func(t *testing.T) {
+			tIpPrefixS := &gotypedjson.TypedJson{Type: gotypedjson.IP_PREFIX_SLICE, Value: []netip.Prefix{{}}}
+			data, err := json.Marshal(tIpPrefixS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty slice", func(t *testing.T) {
+			tIpPrefixS := &gotypedjson.TypedJson{Type: gotypedjson.IP_PREFIX_SLICE, Value: []netip.Prefix{}}
+
+			data, err := json.Marshal(tIpPrefixS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It can encode multiple value properly", func(t *testing.T) {
+			tIpPrefixS := &gotypedjson.TypedJson{Type: gotypedjson.IP_PREFIX_SLICE, Value: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("2001:db8::/32")}}
+
+			data, err := json.Marshal(tIpPrefixS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataMulti))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an invalid value", func(t *testing.T) {
+			tIpPrefixS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_ip_prefix_array","Value":"hello"}`), tIpPrefixS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`failed to convert 'hello' to an ip prefix: netip.ParsePrefix("hello"): no '/'`))
+		})
+
+		t.Run("It can decode an empty slice", func(t *testing.T) {
+			tIpPrefixS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tIpPrefixS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tIpPrefixS.Type).To(Equal(gotypedjson.IP_PREFIX_SLICE))
+			g.Expect(tIpPrefixS.Value.([]netip.Prefix)).To(Equal([]netip.Prefix{}))
+		})
+
+		t.Run("It can decode multiple values properly", func(t *testing.T) {
+			tIpPrefixS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataMulti), tIpPrefixS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tIpPrefixS.Type).To(Equal(gotypedjson.IP_PREFIX_SLICE))
+			g.Expect(tIpPrefixS.Value.([]netip.Prefix)).To(Equal([]netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("2001:db8::/32")}))
+		})
+	})
+}
+
+func Test_AddrPort_Slice(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_addr_port_array","Value":""}`
+	rawDataMulti := `{"Type":"_addr_port_array","Value":"10.0.0.1:80,[::1]:443"}`
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tAddrPortS := &gotypedjson.TypedJson{Type: gotypedjson.ADDR_PORT_SLICE, Value: "nope"}
+			data, err := json.Marshal(tAddrPortS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a []netip.AddrPort"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It returns an error if a value is invalid", func(t *testing.T) {
+			tAddrPortS := &gotypedjson.TypedJson{Type: gotypedjson.ADDR_PORT_SLICE, Value: []netip.AddrPort{{}}}
+			data, err := json.Marshal(tAddrPortS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty slice", func(t *testing.T) {
+			tAddrPortS := &gotypedjson.TypedJson{Type: gotypedjson.ADDR_PORT_SLICE, Value: []netip.AddrPort{}}
+
+			data, err := json.Marshal(tAddrPortS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It can encode multiple value properly", func(t *testing.T) {
+			tAddrPortS := &gotypedjson.TypedJson{Type: gotypedjson.ADDR_PORT_SLICE, Value: []netip.AddrPort{netip.MustParseAddrPort("10.0.0.1:80"), netip.MustParseAddrPort("[::1]:443")}}
+
+			data, err := json.Marshal(tAddrPortS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataMulti))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an invalid value", func(t *testing.T) {
+			tAddrPortS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_addr_port_array","Value":"hello"}`), tAddrPortS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`failed to convert 'hello' to an addr port: not an ip:port`))
+		})
+
+		t.Run("It can decode an empty slice", func(t *testing.T) {
+			tAddrPortS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tAddrPortS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tAddrPortS.Type).To(Equal(gotypedjson.ADDR_PORT_SLICE))
+			g.Expect(tAddrPortS.Value.([]netip.AddrPort)).To(Equal([]netip.AddrPort{}))
+		})
+
+		t.Run("It can decode multiple values properly", func(t *testing.T) {
+			tAddrPortS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataMulti), tAddrPortS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tAddrPortS.Type).To(Equal(gotypedjson.ADDR_PORT_SLICE))
+			g.Expect(tAddrPortS.Value.([]netip.AddrPort)).To(Equal([]netip.AddrPort{netip.MustParseAddrPort("10.0.0.1:80"), netip.MustParseAddrPort("[::1]:443")}))
+		})
+	})
+}
+
+func Test_Mac_Slice(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_mac_array","Value":""}`
+	rawDataMulti := `{"Type":"_mac_array","Value":"00:1b:63:84:45:e6,02:00:5e:10:00:00"}`
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tMacS := &gotypedjson.TypedJson{Type: gotypedjson.MAC_SLICE, Value: "nope"}
+			data, err := json.Marshal(tMacS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a []net.HardwareAddr"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It returns an error if a value is invalid", func(t *testing.T) {
+			tMacS := &gotypedjson.TypedJson{Type: gotypedjson.MAC_SLICE, Value: []net.HardwareAddr{{}}}
+			data, err := json.Marshal(tMacS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty slice", func(t *testing.T) {
+			tMacS := &gotypedjson.TypedJson{Type: gotypedjson.MAC_SLICE, Value: []net.HardwareAddr{}}
+
+			data, err := json.Marshal(tMacS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It can encode multiple value properly", func(t *testing.T) {
+			tMacS := &gotypedjson.TypedJson{Type: gotypedjson.MAC_SLICE, Value: []net.HardwareAddr{{0x00, 0x1b, 0x63, 0x84, 0x45, 0xe6}, {0x02, 0x00, 0x5e, 0x10, 0x00, 0x00}}}
+
+			data, err := json.Marshal(tMacS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataMulti))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an invalid value", func(t *testing.T) {
+			tMacS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_mac_array","Value":"hello"}`), tMacS)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`failed to convert 'hello' to a mac: address hello: invalid MAC address`))
+		})
+
+		t.Run("It can decode an empty slice", func(t *testing.T) {
+			tMacS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tMacS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tMacS.Type).To(Equal(gotypedjson.MAC_SLICE))
+			g.Expect(tMacS.Value.([]net.HardwareAddr)).To(Equal([]net.HardwareAddr{}))
+		})
+
+		t.Run("It can decode multiple values properly", func(t *testing.T) {
+			tMacS := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataMulti), tMacS)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tMacS.Type).To(Equal(gotypedjson.MAC_SLICE))
+			g.Expect(tMacS.Value.([]net.HardwareAddr)).To(Equal([]net.HardwareAddr{{0x00, 0x1b, 0x63, 0x84, 0x45, 0xe6}, {0x02, 0x00, 0x5e, 0x10, 0x00, 0x00}}))
+		})
+	})
+}
+
+func Test_Url(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawData := `{"Type":"_url","Value":"https://example.com/hooks?id=1"}`
+	testUrl, _ := url.Parse("https://example.com/hooks?id=1")
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tUrl := &gotypedjson.TypedJson{Type: gotypedjson.URL, Value: "https://example.com"}
+			data, err := json.Marshal(tUrl)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'https://example.com' to a url"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode the value properly", func(t *testing.T) {
+			tUrl := &gotypedjson.TypedJson{Type: gotypedjson.URL, Value: testUrl}
+
+			data, err := json.Marshal(tUrl)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			tUrl := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_url","Value":"http://[::1"}`), tUrl)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`failed to convert 'http://[::1' to a url: parse "http://[::1": missing ']' in host`))
+		})
+
+		t.Run("It can decode the value properly", func(t *testing.T) {
+			tUrl := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tUrl)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tUrl.Type).To(Equal(gotypedjson.URL))
+			g.Expect(tUrl.Value.(*url.URL)).To(Equal(testUrl))
+		})
+	})
+}
+
+func Test_Bytes(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_bytes","Value":""}`
+	rawData := `{"Type":"_bytes","Value":"aGVsbG8g+/8="}`
+	rawDataURL := `{"Type":"_bytes_url","Value":"aGVsbG8g-_8="}`
+	rawDataRaw := `{"Type":"_bytes_raw","Value":"aGVsbG8g+/8"}`
+	rawDataRawURL := `{"Type":"_bytes_raw_url","Value":"aGVsbG8g-_8"}`
+
+	testBytes := []byte{'h', 'e', 'l', 'l', 'o', ' ', 0xfb, 0xff}
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tBytes := &gotypedjson.TypedJson{Type: gotypedjson.BYTES, Value: "nope"}
+			data, err := json.Marshal(tBytes)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a []byte"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty value", func(t *testing.T) {
+			tBytes := &gotypedjson.TypedJson{Type: gotypedjson.BYTES, Value: []byte{}}
+
+			data, err := json.Marshal(tBytes)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It can encode the value properly", func(t *testing.T) {
+			tBytes := &gotypedjson.TypedJson{Type: gotypedjson.BYTES, Value: testBytes}
+
+			data, err := json.Marshal(tBytes)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+
+		t.Run("It can encode the url safe value properly", func(t *testing.T) {
+			tBytes := &gotypedjson.TypedJson{Type: gotypedjson.BYTES_URL, Value: testBytes}
+
+			data, err := json.Marshal(tBytes)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataURL))
+		})
+
+		t.Run("It can encode the raw value properly", func(t *testing.T) {
+			tBytes := &gotypedjson.TypedJson{Type: gotypedjson.BYTES_RAW, Value: testBytes}
+
+			data, err := json.Marshal(tBytes)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataRaw))
+		})
+
+		t.Run("It can encode the raw url safe value properly", func(t *testing.T) {
+			tBytes := &gotypedjson.TypedJson{Type: gotypedjson.BYTES_RAW_URL, Value: testBytes}
+
+			data, err := json.Marshal(tBytes)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataRawURL))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			tBytes := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_bytes","Value":"aGVsbG8g-_8="}`), tBytes)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert 'aGVsbG8g-_8=' to a []byte"))
+		})
+
+		t.Run("It can decode an empty value", func(t *testing.T) {
+			tBytes := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tBytes)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tBytes.Type).To(Equal(gotypedjson.BYTES))
+			g.Expect(tBytes.Value.([]byte)).To(Equal([]byte{}))
+		})
+
+		t.Run("It can decode all the encodings properly", func(t *testing.T) {
+			for jsonType, rawData := range map[gotypedjson.JSONTYPE]string{
+				gotypedjson.BYTES:         rawData,
+				gotypedjson.BYTES_URL:     rawDataURL,
+				gotypedjson.BYTES_RAW:     rawDataRaw,
+				gotypedjson.BYTES_RAW_URL: rawDataRawURL,
+			} {
+				tBytes := &gotypedjson.TypedJson{}
+
+				err := json.Unmarshal([]byte(rawData), tBytes)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(tBytes.Type).To(Equal(jsonType))
+				g.Expect(tBytes.Value.([]byte)).To(Equal(testBytes))
+			}
+		})
+	})
+}
+
+func Test_Object(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_object","Value":{}}`
+	rawData := `{"Type":"_object","Value":{"one":{"Type":"_int","Value":"1"},"two":{"Type":"_object","Value":{"three":{"Type":"_string","Value":"3"}}}}}`
+
+	codec := gotypedjson.CustomCodec{
+		gotypedjson.INT: {
+			Encode: func(val any) (string, error) {
+				return fmt.Sprintf("%d", val.(int)+5), nil
+			},
+			Decode: func(s string) (any, error) {
+				val, err := strconv.ParseInt(s, 10, 0)
+				if err != nil {
+					return nil, fmt.Errorf("failed to convert %s, to an int", s)
+				}
+
+				return int(val) - 5, nil
+			},
+		},
+	}
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tObject := &gotypedjson.TypedJson{Type: gotypedjson.OBJECT, Value: "nope"}
+			data, err := json.Marshal(tObject)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a map[string]*TypedJson"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It returns an error if a nested value fails to encode", func(t *testing.T) {
+			tObject := &gotypedjson.TypedJson{Type: gotypedjson.OBJECT, Value: map[string]*gotypedjson.TypedJson{
+				"one": {Type: gotypedjson.INT, Value: "nope"},
+			}}
+			data, err := json.Marshal(tObject)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to encode key 'one': failed to cast 'nope' to an int"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty object", func(t *testing.T) {
+			tObject := &gotypedjson.TypedJson{Type: gotypedjson.OBJECT, Value: map[string]*gotypedjson.TypedJson{}}
+
+			data, err := json.Marshal(tObject)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It can encode nested values properly", func(t *testing.T) {
+			tObject := &gotypedjson.TypedJson{Type: gotypedjson.OBJECT, Value: map[string]*gotypedjson.TypedJson{
+				"one": {Type: gotypedjson.INT, Value: 1},
+				"two": {Type: gotypedjson.OBJECT, Value: map[string]*gotypedjson.TypedJson{
+					"three": {Type: gotypedjson.STRING, Value: "3"},
+				}},
+			}}
+
+			data, err := json.Marshal(tObject)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+
+		t.Run("It uses the parent codec for nested values", func(t *testing.T) {
+			tObject := gotypedjson.NewTypedJson(gotypedjson.OBJECT, map[string]*gotypedjson.TypedJson{
+				"one": {Type: gotypedjson.OBJECT, Value: map[string]*gotypedjson.TypedJson{
+					"two": {Type: gotypedjson.INT, Value: 5},
+				}},
+			}, codec)
+
+			data, err := json.Marshal(tObject)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_object","Value":{"one":{"Type":"_object","Value":{"two":{"Type":"_int","Value":"10"}}}}}`))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			tObject := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_object","Value":"nope"}`), tObject)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`failed to convert '"nope"' to an object`))
+		})
+
+		t.Run("It fails to decode an incorrect nested value", func(t *testing.T) {
+			tObject := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_object","Value":{"one":{"Type":"_int","Value":"nope"}}}`), tObject)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to decode key 'one': failed to convert 'nope' to an int"))
+		})
+
+		t.Run("It fails to decode a malformed nested value", func(t *testing.T) {
+			tObject := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_object","Value":{"one":5}}`), tObject)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(HavePrefix("failed to decode key 'one': failed to convert '5' to a TypedJson: "))
+
+			err = json.Unmarshal([]byte(`{"Type":"_object","Value":{"one":{"Type":"_array","Value":[true]}}}`), tObject)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(HavePrefix("failed to decode key 'one': failed to decode index 0: failed to convert 'true' to a TypedJson: "))
+			g.Expect(strings.Count(err.Error(), "to a TypedJson")).To(Equal(1))
+		})
+
+		t.Run("It can decode an empty object", func(t *testing.T) {
+			tObject := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tObject)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tObject.Type).To(Equal(gotypedjson.OBJECT))
+			g.Expect(tObject.Value.(map[string]*gotypedjson.TypedJson)).To(BeEmpty())
+		})
+
+		t.Run("It can decode nested values properly", func(t *testing.T) {
+			tObject := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tObject)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tObject.Type).To(Equal(gotypedjson.OBJECT))
+
+			values := tObject.Value.(map[string]*gotypedjson.TypedJson)
+			g.Expect(values).To(HaveLen(2))
+			g.Expect(values["one"].Type).To(Equal(gotypedjson.INT))
+			g.Expect(values["one"].Value.(int)).To(Equal(1))
+			g.Expect(values["two"].Type).To(Equal(gotypedjson.OBJECT))
+
+			nested := values["two"].Value.(map[string]*gotypedjson.TypedJson)
+			g.Expect(nested).To(HaveLen(1))
+			g.Expect(nested["three"].Type).To(Equal(gotypedjson.STRING))
+			g.Expect(nested["three"].Value.(string)).To(Equal("3"))
+		})
+
+		t.Run("It uses the parent codec for nested values", func(t *testing.T) {
+			tObject := gotypedjson.NewTypedJsonDecoder(codec)
+
+			err := json.Unmarshal([]byte(`{"Type":"_object","Value":{"one":{"Type":"_object","Value":{"two":{"Type":"_int","Value":"10"}}}}}`), tObject)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			nested := tObject.Value.(map[string]*gotypedjson.TypedJson)["one"].Value.(map[string]*gotypedjson.TypedJson)
+			g.Expect(nested["two"].Value.(int)).To(Equal(5))
+
+			// the decoded values keep the codec when re-encoded
+			data, err := json.Marshal(tObject)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_object","Value":{"one":{"Type":"_object","Value":{"two":{"Type":"_int","Value":"10"}}}}}`))
+		})
+	})
+}
+
+func Test_Array(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawDataEmpty := `{"Type":"_array","Value":[]}`
+	rawData := `{"Type":"_array","Value":[{"Type":"_int64","Value":"1"},{"Type":"_datetime","Value":"2024-01-02T03:04:05Z"},{"Type":"_array","Value":[{"Type":"_bool","Value":"true"}]}]}`
+
+	testTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	codec := gotypedjson.CustomCodec{
+		gotypedjson.JSONTYPE("custom"): {
+			Encode: func(val any) (string, error) {
+				return strconv.FormatInt(int64(val.(int)), 2), nil
+			},
+			Decode: func(s string) (any, error) {
+				val, err := strconv.ParseInt(s, 2, 0)
+				if err != nil {
+					return nil, fmt.Errorf("failed to convert %s, to an int", s)
+				}
+
+				return int(val), nil
+			},
+		},
+	}
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tArray := &gotypedjson.TypedJson{Type: gotypedjson.ARRAY, Value: "nope"}
+			data, err := json.Marshal(tArray)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast 'nope' to a []*TypedJson"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It returns an error if a nested value fails to encode", func(t *testing.T) {
+			tArray := &gotypedjson.TypedJson{Type: gotypedjson.ARRAY, Value: []*gotypedjson.TypedJson{
+				{Type: gotypedjson.INT, Value: 1},
+				{Type: gotypedjson.INT, Value: "nope"},
+			}}
+			data, err := json.Marshal(tArray)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to encode index 1: failed to cast 'nope' to an int"))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It can encode an empty array", func(t *testing.T) {
+			tArray := &gotypedjson.TypedJson{Type: gotypedjson.ARRAY, Value: []*gotypedjson.TypedJson{}}
+
+			data, err := json.Marshal(tArray)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawDataEmpty))
+		})
+
+		t.Run("It can encode mixed values properly", func(t *testing.T) {
+			tArray := &gotypedjson.TypedJson{Type: gotypedjson.ARRAY, Value: []*gotypedjson.TypedJson{
+				{Type: gotypedjson.INT64, Value: int64(1)},
+				{Type: gotypedjson.DATETIME, Value: testTime},
+				{Type: gotypedjson.ARRAY, Value: []*gotypedjson.TypedJson{{Type: gotypedjson.BOOL, Value: true}}},
+			}}
+
+			data, err := json.Marshal(tArray)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+
+		t.Run("It uses the parent codec for nested values", func(t *testing.T) {
+			tArray := gotypedjson.NewTypedJson(gotypedjson.ARRAY, []*gotypedjson.TypedJson{
+				{Type: gotypedjson.JSONTYPE("custom"), Value: 5},
+				{Type: gotypedjson.INT, Value: 5},
+			}, codec)
+
+			data, err := json.Marshal(tArray)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_array","Value":[{"Type":"custom","Value":"101"},{"Type":"_int","Value":"5"}]}`))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode an incorrect value", func(t *testing.T) {
+			tArray := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_array","Value":"nope"}`), tArray)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`failed to convert '"nope"' to an array`))
+		})
+
+		t.Run("It fails to decode an incorrect nested value", func(t *testing.T) {
+			tArray := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_array","Value":[{"Type":"_int","Value":"nope"}]}`), tArray)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to decode index 0: failed to convert 'nope' to an int"))
+		})
+
+		t.Run("It fails to decode a malformed nested value", func(t *testing.T) {
+			tArray := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_array","Value":["nope"]}`), tArray)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(HavePrefix(`failed to decode index 0: failed to convert '"nope"' to a TypedJson: `))
+		})
+
+		t.Run("It can decode an empty array", func(t *testing.T) {
+			tArray := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawDataEmpty), tArray)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tArray.Type).To(Equal(gotypedjson.ARRAY))
+			g.Expect(tArray.Value.([]*gotypedjson.TypedJson)).To(BeEmpty())
+		})
+
+		t.Run("It can decode mixed values properly", func(t *testing.T) {
+			tArray := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tArray)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tArray.Type).To(Equal(gotypedjson.ARRAY))
+
+			values := tArray.Value.([]*gotypedjson.TypedJson)
+			g.Expect(values).To(HaveLen(3))
+			g.Expect(values[0].Type).To(Equal(gotypedjson.INT64))
+			g.Expect(values[0].Value.(int64)).To(Equal(int64(1)))
+			g.Expect(values[1].Type).To(Equal(gotypedjson.DATETIME))
+			g.Expect(values[1].Value.(time.Time).Equal(testTime)).To(BeTrue())
+			g.Expect(values[2].Type).To(Equal(gotypedjson.ARRAY))
+			g.Expect(values[2].Value.([]*gotypedjson.TypedJson)[0].Value.(bool)).To(BeTrue())
+		})
+
+		t.Run("It uses the parent codec for nested values", func(t *testing.T) {
+			tArray := gotypedjson.NewTypedJsonDecoder(codec)
+
+			err := json.Unmarshal([]byte(`{"Type":"_array","Value":[{"Type":"custom","Value":"101"},{"Type":"_int","Value":"5"}]}`), tArray)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			values := tArray.Value.([]*gotypedjson.TypedJson)
+			g.Expect(values[0].Type).To(Equal(gotypedjson.JSONTYPE("custom")))
+			g.Expect(values[0].Value.(int)).To(Equal(5))
+			g.Expect(values[1].Type).To(Equal(gotypedjson.INT))
+			g.Expect(values[1].Value.(int)).To(Equal(5))
+		})
+	})
+}
+
+func Test_Json(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rawData := `{"Type":"_json","Value":{"a":[1,2.50,"three",null],"b":{"c":true}}}`
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tJson := &gotypedjson.TypedJson{Type: gotypedjson.JSON, Value: `{"a":1}`}
+			data, err := json.Marshal(tJson)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast '{"a":1}' to json`))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It returns an error if the value is not valid json", func(t *testing.T) {
+			tJson := &gotypedjson.TypedJson{Type: gotypedjson.JSON, Value: json.RawMessage(`{"a":}`)}
+			data, err := json.Marshal(tJson)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`json: error calling MarshalJSON for type *gotypedjson.TypedJson: failed to cast '{"a":}' to json: invalid json`))
+			g.Expect(data).To(BeNil())
+		})
+
+		t.Run("It embeds the value without interpreting it", func(t *testing.T) {
+			tJson := &gotypedjson.TypedJson{Type: gotypedjson.JSON, Value: json.RawMessage(`{"a": [1, 2.50, "three", null],
+				"b": {"c": true}}`)}
+
+			data, err := json.Marshal(tJson)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(rawData))
+		})
+
+		t.Run("It can be nested in an object", func(t *testing.T) {
+			tObject := &gotypedjson.TypedJson{Type: gotypedjson.OBJECT, Value: map[string]*gotypedjson.TypedJson{
+				"raw": {Type: gotypedjson.JSON, Value: json.RawMessage(`"text"`)},
+			}}
+
+			data, err := json.Marshal(tObject)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_object","Value":{"raw":{"Type":"_json","Value":"text"}}}`))
+		})
+
+		t.Run("It does not escape html characters", func(t *testing.T) {
+			tObject := &gotypedjson.TypedJson{Type: gotypedjson.ARRAY, Value: []*gotypedjson.TypedJson{
+				{Type: gotypedjson.JSON, Value: json.RawMessage(`{"a": "<b>&"}`)},
+			}}
+
+			data, err := tObject.MarshalJSON()
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_array","Value":[{"Type":"_json","Value":{"a":"<b>&"}}]}`))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It fails to decode a missing value", func(t *testing.T) {
+			tJson := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_json"}`), tJson)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert '' to json"))
+		})
+
+		t.Run("It can decode the value without interpreting it", func(t *testing.T) {
+			tJson := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(rawData), tJson)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tJson.Type).To(Equal(gotypedjson.JSON))
+			g.Expect(tJson.Value.(json.RawMessage)).To(Equal(json.RawMessage(`{"a":[1,2.50,"three",null],"b":{"c":true}}`)))
+		})
+
+		t.Run("It decodes null as raw json", func(t *testing.T) {
+			tJson := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_json","Value":null}`), tJson)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tJson.Type).To(Equal(gotypedjson.JSON))
+			g.Expect(tJson.Value).To(Equal(json.RawMessage(`null`)))
+			g.Expect(tJson.MustJson()).To(Equal(json.RawMessage(`null`)))
+		})
+	})
+}
+
+func Test_Null(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	jsonTypes := []gotypedjson.JSONTYPE{
+		gotypedjson.INT, gotypedjson.INT8, gotypedjson.INT16, gotypedjson.INT32, gotypedjson.INT64,
+		gotypedjson.UINT, gotypedjson.UINT8, gotypedjson.UINT16, gotypedjson.UINT32, gotypedjson.UINT64,
+		gotypedjson.FLOAT32, gotypedjson.FLOAT64, gotypedjson.STRING, gotypedjson.BOOL, gotypedjson.DATETIME,
+		gotypedjson.TIME_DURATION, gotypedjson.COMPLEX64, gotypedjson.COMPLEX128, gotypedjson.INT_SLICE,
+		gotypedjson.STRING_SLICE, gotypedjson.BIG_INT, gotypedjson.DECIMAL, gotypedjson.UUID, gotypedjson.IP,
+		gotypedjson.URL, gotypedjson.BYTES, gotypedjson.OBJECT, gotypedjson.ARRAY,
+	}
+
+	codec := gotypedjson.CustomCodec{
+		gotypedjson.JSONTYPE("custom"): {
+			Encode: func(val any) (string, error) { return "", fmt.Errorf("should not be called") },
+			Decode: func(s string) (any, error) { return nil, fmt.Errorf("should not be called") },
+		},
+	}
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It encodes a nil value as null for every type", func(t *testing.T) {
+			for _, jsonType := range jsonTypes {
+				data, err := json.Marshal(&gotypedjson.TypedJson{Type: jsonType, Value: nil})
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(string(data)).To(Equal(fmt.Sprintf(`{"Type":"%s","Value":null}`, jsonType)))
+			}
+		})
+
+		t.Run("It encodes typed nil values as null", func(t *testing.T) {
+			data, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.BIG_INT, Value: (*big.Int)(nil)})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_bigint","Value":null}`))
+
+			data, err = json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.INT_SLICE, Value: []int(nil)})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_int_array","Value":null}`))
+		})
+
+		t.Run("It does not call the codec for nil values", func(t *testing.T) {
+			data, err := json.Marshal(gotypedjson.NewTypedJson(gotypedjson.JSONTYPE("custom"), nil, codec))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"custom","Value":null}`))
+		})
+
+		t.Run("It encodes nested nil values as null", func(t *testing.T) {
+			tObject := &gotypedjson.TypedJson{Type: gotypedjson.OBJECT, Value: map[string]*gotypedjson.TypedJson{
+				"one": nil,
+				"two": {Type: gotypedjson.INT, Value: nil},
+			}}
+
+			data, err := json.Marshal(tObject)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_object","Value":{"one":null,"two":{"Type":"_int","Value":null}}}`))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It decodes null as a nil value for every type", func(t *testing.T) {
+			for _, jsonType := range jsonTypes {
+				tNull := &gotypedjson.TypedJson{}
+
+				err := json.Unmarshal([]byte(fmt.Sprintf(`{"Type":"%s","Value":null}`, jsonType)), tNull)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(tNull.Type).To(Equal(jsonType))
+				g.Expect(tNull.Value).To(BeNil())
+			}
+		})
+
+		t.Run("It does not call the codec for null values", func(t *testing.T) {
+			tNull := gotypedjson.NewTypedJsonDecoder(codec)
+
+			err := json.Unmarshal([]byte(`{"Type":"custom","Value":null}`), tNull)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tNull.Type).To(Equal(gotypedjson.JSONTYPE("custom")))
+			g.Expect(tNull.Value).To(BeNil())
+		})
+
+		t.Run("It decodes nested null values", func(t *testing.T) {
+			tObject := &gotypedjson.TypedJson{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_object","Value":{"one":null,"two":{"Type":"_int","Value":null}}}`), tObject)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			values := tObject.Value.(map[string]*gotypedjson.TypedJson)
+			g.Expect(values).To(HaveKey("one"))
+			g.Expect(values["one"]).To(BeNil())
+			g.Expect(values["two"].Type).To(Equal(gotypedjson.INT))
+			g.Expect(values["two"].Value).To(BeNil())
+		})
+	})
+}
+
 func Test_Codec(t *testing.T) {
 	g := NewGomegaWithT(t)
 
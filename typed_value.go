@@ -0,0 +1,119 @@
+package gotypedjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"time"
+)
+
+// DefaultGoType is the set of Go types that have one of the default types. It is used as the constraint of
+// TypedValue so an unsupported Go type is caught by the compiler. Go limits a constraint to 100 types, so
+// multi-dimensional slices are not included and can use a TypedJson with SliceOf instead.
+type DefaultGoType interface {
+	scalarGoType | sliceGoType | stringMapGoType
+}
+
+// scalarGoType are the Go types of the scalar types, along with OBJECT, ARRAY and JSON
+type scalarGoType interface {
+	int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 |
+		float32 | float64 | string | bool | time.Time | time.Duration | complex64 | complex128 |
+		*big.Int | *big.Float | *big.Rat | Int128 | Uint128 | Decimal | Date | TimeOfDay | Uuid |
+		netip.Addr | netip.Prefix | netip.AddrPort | net.HardwareAddr | *url.URL |
+		map[string]*TypedJson | []*TypedJson | json.RawMessage
+}
+
+// sliceGoType are the Go types of the slice types, where []uint8 is the BYTES type
+type sliceGoType interface {
+	[]int | []int8 | []int16 | []int32 | []int64 | []uint | []uint8 | []uint16 | []uint32 | []uint64 |
+		[]float32 | []float64 | []string | []bool | []time.Time | []time.Duration | []complex64 | []complex128 |
+		[]*big.Int | []*big.Float | []*big.Rat | []Int128 | []Uint128 | []Date | []TimeOfDay | []Uuid |
+		[]netip.Addr | []netip.Prefix | []netip.AddrPort | []net.HardwareAddr
+}
+
+// stringMapGoType are the Go types of the string keyed map types
+type stringMapGoType interface {
+	map[string]int | map[string]int8 | map[string]int16 | map[string]int32 | map[string]int64 |
+		map[string]uint | map[string]uint8 | map[string]uint16 | map[string]uint32 | map[string]uint64 |
+		map[string]float32 | map[string]float64 | map[string]string | map[string]bool |
+		map[string]time.Time | map[string]time.Duration | map[string]complex64 | map[string]complex128 |
+		map[string]*big.Int | map[string]*big.Float | map[string]*big.Rat | map[string]Int128 | map[string]Uint128 |
+		map[string]Decimal | map[string]Date | map[string]TimeOfDay | map[string]Uuid | map[string]netip.Addr |
+		map[string]netip.Prefix | map[string]netip.AddrPort | map[string]net.HardwareAddr | map[string]*url.URL
+}
+
+// TypedValue is a TypedJson where the Go type of the Value is known at compile time. The JSONTYPE is derived from
+// T, so it is encoded and decoded with exactly the same format as a TypedJson holding the same Value. The zero value
+// holds the zero value of T and is ready to use.
+//
+// T must be one of the DefaultGoType types, such as int64, []string, time.Time or map[string]bool. For Go types shared
+// by multiple types, the inferred type is used. For example []byte is always BYTES.
+//
+// Methods that only read the Value use value receivers, so a TypedValue is encoded even when it is a field of a
+// struct that is not addressable. Set and UnmarshalJSON modify the Value and use pointer receivers.
+type TypedValue[T DefaultGoType] struct {
+	value T
+}
+
+//	PARAMETERS:
+//	* value - Value to encode and decode
+//
+//	RETURNS:
+//	* *TypedValue[T] - typed value that can encode/decode typed json
+//
+// Returns an initialized TypedValue
+func NewTypedValue[T DefaultGoType](value T) *TypedValue[T] {
+	return &TypedValue[T]{value: value}
+}
+
+// Get returns the Value
+func (typedValue TypedValue[T]) Get() T {
+	return typedValue.value
+}
+
+// Set replaces the Value
+func (typedValue *TypedValue[T]) Set(value T) {
+	typedValue.value = value
+}
+
+// Type returns the JSONTYPE derived from T
+func (typedValue TypedValue[T]) Type() JSONTYPE {
+	jsonType, _ := jsonTypeOf(reflect.TypeFor[T]())
+	return jsonType
+}
+
+func (typedValue TypedValue[T]) MarshalJSON() ([]byte, error) {
+	return (&TypedJson{Type: typedValue.Type(), Value: typedValue.value}).MarshalJSON()
+}
+
+func (typedValue *TypedValue[T]) UnmarshalJSON(b []byte) error {
+	jsonType := typedValue.Type()
+
+	typedJson := &TypedJson{}
+	if err := typedJson.UnmarshalJSON(b); err != nil {
+		return err
+	}
+
+	if typedJson.Type != jsonType {
+		return fmt.Errorf("failed to decode type '%s' as a TypedValue of type '%s'", typedJson.Type, jsonType)
+	}
+
+	// null is decoded as the zero value
+	if typedJson.Value == nil {
+		var zero T
+		typedValue.value = zero
+		return nil
+	}
+
+	value, ok := typedJson.Value.(T)
+	if !ok {
+		return fmt.Errorf("failed to cast '%v' to a %s", typedJson.Value, reflect.TypeFor[T]())
+	}
+
+	typedValue.value = value
+	return nil
+}
@@ -0,0 +1,88 @@
+package gotypedjson_test
+
+import (
+	"encoding/json"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	gotypedjson "github.com/DanLavine/go-typed-json"
+	. "github.com/onsi/gomega"
+)
+
+func Test_TypedValue(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("It derives the JSONTYPE from the Go type", func(t *testing.T) {
+		g.Expect(gotypedjson.NewTypedValue(int64(5)).Type()).To(Equal(gotypedjson.INT64))
+		g.Expect(gotypedjson.NewTypedValue([]string{}).Type()).To(Equal(gotypedjson.STRING_SLICE))
+		g.Expect(gotypedjson.NewTypedValue(time.Time{}).Type()).To(Equal(gotypedjson.DATETIME))
+		g.Expect(gotypedjson.NewTypedValue(netip.Addr{}).Type()).To(Equal(gotypedjson.IP))
+		g.Expect(gotypedjson.NewTypedValue([]byte{}).Type()).To(Equal(gotypedjson.BYTES))
+		g.Expect(gotypedjson.NewTypedValue([]net.HardwareAddr{}).Type()).To(Equal(gotypedjson.MAC_SLICE))
+		g.Expect(gotypedjson.NewTypedValue(gotypedjson.Decimal{}).Type()).To(Equal(gotypedjson.DECIMAL))
+		g.Expect(gotypedjson.NewTypedValue(map[string]bool{}).Type()).To(Equal(gotypedjson.STRING_BOOL_MAP))
+		g.Expect(gotypedjson.NewTypedValue(map[string]*gotypedjson.TypedJson{}).Type()).To(Equal(gotypedjson.OBJECT))
+	})
+
+	t.Run("It can get and set the value", func(t *testing.T) {
+		tValue := gotypedjson.NewTypedValue(uint16(1))
+		g.Expect(tValue.Get()).To(Equal(uint16(1)))
+
+		tValue.Set(2)
+		g.Expect(tValue.Get()).To(Equal(uint16(2)))
+	})
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It uses the same format as a TypedJson", func(t *testing.T) {
+			data, err := json.Marshal(gotypedjson.NewTypedValue([]int32{1, 2}))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			expected, err := json.Marshal(&gotypedjson.TypedJson{Type: gotypedjson.INT32_SLICE, Value: []int32{1, 2}})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(string(expected)))
+		})
+
+		t.Run("It can be encoded as a struct field", func(t *testing.T) {
+			data, err := json.Marshal(struct{ Count gotypedjson.TypedValue[int8] }{})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Count":{"Type":"_int8","Value":"0"}}`))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It returns an error when the type does not match", func(t *testing.T) {
+			tValue := &gotypedjson.TypedValue[int64]{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_int32","Value":"5"}`), tValue)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to decode type '_int32' as a TypedValue of type '_int64'"))
+		})
+
+		t.Run("It decodes null as the zero value", func(t *testing.T) {
+			tValue := gotypedjson.NewTypedValue([]string{"a"})
+
+			err := json.Unmarshal([]byte(`{"Type":"_string_array","Value":null}`), tValue)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tValue.Get()).To(BeNil())
+		})
+
+		t.Run("It can decode the value", func(t *testing.T) {
+			tValue := &gotypedjson.TypedValue[time.Duration]{}
+
+			err := json.Unmarshal([]byte(`{"Type":"_duration","Value":"1m30s"}`), tValue)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tValue.Get()).To(Equal(90 * time.Second))
+		})
+
+		t.Run("It round trips int64 slices", func(t *testing.T) {
+			data, err := json.Marshal(gotypedjson.NewTypedValue([]int64{1000, -70000}))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			tValue := &gotypedjson.TypedValue[[]int64]{}
+			g.Expect(json.Unmarshal(data, tValue)).ToNot(HaveOccurred())
+			g.Expect(tValue.Get()).To(Equal([]int64{1000, -70000}))
+		})
+	})
+}
@@ -0,0 +1,135 @@
+package gotypedjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Union is a registry of Go types that can all be stored under a single JSONTYPE, such as a family of event
+// structs. Each Go type is registered as a variant with its own name, which is encoded along with the value so
+// the exact Go type can be constructed again when decoding.
+type Union struct {
+	name JSONTYPE
+
+	variants map[JSONTYPE]reflect.Type
+	names    map[reflect.Type]JSONTYPE
+}
+
+//	PARAMETERS:
+//	* name - JSONTYPE that the union's codec will be registered under
+//
+//	RETURNS:
+//	* *Union - union without any variants
+//
+// Returns a Union that variants can be registered on
+func NewUnion(name JSONTYPE) *Union {
+	return &Union{
+		name:     name,
+		variants: map[JSONTYPE]reflect.Type{},
+		names:    map[reflect.Type]JSONTYPE{},
+	}
+}
+
+//	PARAMETERS:
+//	* variant   - name that is encoded to identify the Go type
+//	* prototype - value of the Go type for the variant, such as `Created{}` or `&Created{}`
+//
+//	RETURNS:
+//	* *Union - the same union so calls can be chained
+//
+// Registers a Go type as a variant of the union. Values are encoded with `encoding/json`, so registering a pointer
+// type will decode a pointer and registering a struct will decode a struct. This will panic if the prototype is
+// nil or if the variant name or Go type is already registered.
+func (union *Union) Register(variant JSONTYPE, prototype any) *Union {
+	if prototype == nil {
+		panic(fmt.Sprintf("variant %s has a nil prototype", variant))
+	}
+
+	goType := reflect.TypeOf(prototype)
+	if _, ok := union.variants[variant]; ok {
+		panic(fmt.Sprintf("variant %s is already registered", variant))
+	}
+
+	if name, ok := union.names[goType]; ok {
+		panic(fmt.Sprintf("type %s is already registered as variant %s", goType, name))
+	}
+
+	union.variants[variant] = goType
+	union.names[goType] = variant
+
+	return union
+}
+
+//	RETURNS:
+//	* Codec - codec that can be set on the union's JSONTYPE of a CustomCodec or the GlobalCodec
+//
+// Returns a codec that encodes the variant name along with the value, picked from the value's Go type. Decoding
+// constructs the Go type that was registered for the variant. Both return an error for an unregistered variant.
+// Variants should be registered before calling Codec so their Go types are included for NewInferredTypedJson.
+func (union *Union) Codec() Codec {
+	goTypes := make([]reflect.Type, 0, len(union.names))
+	for goType := range union.names {
+		goTypes = append(goTypes, goType)
+	}
+
+	return Codec{
+		Encode: func(val any) (string, error) {
+			variant, ok := union.names[reflect.TypeOf(val)]
+			if !ok {
+				return "", fmt.Errorf("failed to cast '%v' to union '%s': type %T is not a registered variant", val, union.name, val)
+			}
+
+			value, err := json.Marshal(val)
+			if err != nil {
+				return "", fmt.Errorf("failed to encode variant %s: %w", variant, err)
+			}
+
+			encoded, err := json.Marshal(unionValue{Type: variant, Value: value})
+			if err != nil {
+				return "", err
+			}
+
+			return string(encoded), nil
+		},
+		Decode: func(s string) (any, error) {
+			encoded := unionValue{}
+			if err := json.Unmarshal([]byte(s), &encoded); err != nil {
+				return nil, fmt.Errorf("failed to convert '%s' to union '%s'", s, union.name)
+			}
+
+			goType, ok := union.variants[encoded.Type]
+			if !ok {
+				return nil, fmt.Errorf("failed to convert '%s' to union '%s': variant '%s' is not one of the registered variants [%s]", s, union.name, encoded.Type, union.variantNames())
+			}
+
+			// for pointer variants, encoding/json allocates the pointed to value
+			value := reflect.New(goType)
+			if err := json.Unmarshal(encoded.Value, value.Interface()); err != nil {
+				return nil, fmt.Errorf("failed to decode variant %s: %w", encoded.Type, err)
+			}
+
+			return value.Elem().Interface(), nil
+		},
+		GoTypes: goTypes,
+	}
+}
+
+// unionValue is the encoded form of a union's variant
+type unionValue struct {
+	Type  JSONTYPE        `json:"Type"`
+	Value json.RawMessage `json:"Value"`
+}
+
+// variantNames returns all the registered variants in sorted order
+func (union *Union) variantNames() string {
+	names := make([]string, 0, len(union.variants))
+	for variant := range union.variants {
+		names = append(names, string(variant))
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, ", ")
+}
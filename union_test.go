@@ -0,0 +1,92 @@
+package gotypedjson_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	gotypedjson "github.com/DanLavine/go-typed-json"
+	. "github.com/onsi/gomega"
+)
+
+type testCreated struct {
+	ID   int
+	Name string
+}
+
+type testDeleted struct {
+	ID int
+}
+
+func Test_Union(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	union := gotypedjson.NewUnion("event").
+		Register("created", testCreated{}).
+		Register("deleted", &testDeleted{})
+
+	codec := gotypedjson.CustomCodec{"event": union.Codec()}
+
+	t.Run("It panics when registering a variant twice", func(t *testing.T) {
+		g.Expect(func() { union.Register("created", 5) }).To(Panic())
+		g.Expect(func() { union.Register("other", testCreated{}) }).To(Panic())
+		g.Expect(func() { union.Register("other", nil) }).To(Panic())
+	})
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error for an unregistered type", func(t *testing.T) {
+			tUnion := gotypedjson.NewTypedJson("event", testDeleted{ID: 1}, codec)
+
+			_, err := tUnion.MarshalJSON()
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to cast '{1}' to union 'event': type gotypedjson_test.testDeleted is not a registered variant"))
+		})
+
+		t.Run("It picks the variant from the Go type", func(t *testing.T) {
+			tUnion := gotypedjson.NewTypedJson("event", testCreated{ID: 1, Name: "one"}, codec)
+
+			data, err := tUnion.MarshalJSON()
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"event","Value":"{\"Type\":\"created\",\"Value\":{\"ID\":1,\"Name\":\"one\"}}"}`))
+
+			tUnion = gotypedjson.NewTypedJson("event", &testDeleted{ID: 2}, codec)
+
+			data, err = tUnion.MarshalJSON()
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"event","Value":"{\"Type\":\"deleted\",\"Value\":{\"ID\":2}}"}`))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It returns an error for an unregistered variant", func(t *testing.T) {
+			tUnion := gotypedjson.NewTypedJsonDecoder(codec)
+
+			err := json.Unmarshal([]byte(`{"Type":"event","Value":"{\"Type\":\"updated\",\"Value\":{}}"}`), tUnion)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`failed to convert '{"Type":"updated","Value":{}}' to union 'event': variant 'updated' is not one of the registered variants [created, deleted]`))
+		})
+
+		t.Run("It returns an error for an invalid value", func(t *testing.T) {
+			tUnion := gotypedjson.NewTypedJsonDecoder(codec)
+
+			err := json.Unmarshal([]byte(`{"Type":"event","Value":"nope"}`), tUnion)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to convert 'nope' to union 'event'"))
+		})
+
+		t.Run("It constructs the registered struct", func(t *testing.T) {
+			tUnion := gotypedjson.NewTypedJsonDecoder(codec)
+
+			err := json.Unmarshal([]byte(`{"Type":"event","Value":"{\"Type\":\"created\",\"Value\":{\"ID\":1,\"Name\":\"one\"}}"}`), tUnion)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tUnion.Value).To(Equal(testCreated{ID: 1, Name: "one"}))
+		})
+
+		t.Run("It constructs the registered pointer", func(t *testing.T) {
+			tUnion := gotypedjson.NewTypedJsonDecoder(codec)
+
+			err := json.Unmarshal([]byte(`{"Type":"event","Value":"{\"Type\":\"deleted\",\"Value\":{\"ID\":2}}"}`), tUnion)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tUnion.Value).To(Equal(&testDeleted{ID: 2}))
+		})
+	})
+}
@@ -0,0 +1,74 @@
+package gotypedjson
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// UrlOptions are the additional validation rules used by a codec created with NewUrlCodec
+type UrlOptions struct {
+	// RequireAbsolute rejects any url that does not have a scheme
+	RequireAbsolute bool
+
+	// Schemes are the only schemes that are allowed. An empty list allows any scheme
+	Schemes []string
+}
+
+//	PARAMETERS:
+//	* options - validation rules for every url that is encoded or decoded
+//
+//	RETURNS:
+//	* Codec - codec that can be set on the URL type of a CustomCodec or the GlobalCodec
+//
+// Returns a codec that encodes and decodes *url.URL values the same way as the default URL type, but also
+// validates that each url matches the provided options.
+func NewUrlCodec(options UrlOptions) Codec {
+	return Codec{
+		Encode: func(val any) (string, error) {
+			value, ok := val.(*url.URL)
+			if !ok || value == nil {
+				return "", fmt.Errorf("failed to cast '%v' to a url", val)
+			}
+
+			if err := options.validate(value); err != nil {
+				return "", err
+			}
+
+			return value.String(), nil
+		},
+		Decode: func(s string) (any, error) {
+			value, err := url.Parse(s)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert '%s' to a url: %w", s, err)
+			}
+
+			if err := options.validate(value); err != nil {
+				return nil, err
+			}
+
+			return value, nil
+		},
+		GoTypes: []reflect.Type{reflect.TypeFor[*url.URL]()},
+	}
+}
+
+// validate ensures the url matches all the options
+func (options UrlOptions) validate(value *url.URL) error {
+	if options.RequireAbsolute && !value.IsAbs() {
+		return fmt.Errorf("url '%s' must be absolute", value)
+	}
+
+	if len(options.Schemes) == 0 {
+		return nil
+	}
+
+	for _, scheme := range options.Schemes {
+		if strings.EqualFold(scheme, value.Scheme) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("url '%s' must use one of the schemes [%s]", value, strings.Join(options.Schemes, ", "))
+}
@@ -0,0 +1,87 @@
+package gotypedjson_test
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	gotypedjson "github.com/DanLavine/go-typed-json"
+	. "github.com/onsi/gomega"
+)
+
+func Test_NewUrlCodec(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	codec := gotypedjson.CustomCodec{
+		gotypedjson.URL: gotypedjson.NewUrlCodec(gotypedjson.UrlOptions{RequireAbsolute: true, Schemes: []string{"https", "wss"}}),
+	}
+
+	t.Run("Encoding", func(t *testing.T) {
+		t.Run("It returns an error if the type can not be cast", func(t *testing.T) {
+			tUrl := gotypedjson.NewTypedJson(gotypedjson.URL, "https://example.com", codec)
+
+			_, err := tUrl.MarshalJSON()
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to cast 'https://example.com' to a url"))
+		})
+
+		t.Run("It returns an error if the url is not absolute", func(t *testing.T) {
+			tUrl := gotypedjson.NewTypedJson(gotypedjson.URL, &url.URL{Path: "/hooks"}, codec)
+
+			_, err := tUrl.MarshalJSON()
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("url '/hooks' must be absolute"))
+		})
+
+		t.Run("It returns an error if the scheme is not allowed", func(t *testing.T) {
+			tUrl := gotypedjson.NewTypedJson(gotypedjson.URL, &url.URL{Scheme: "http", Host: "example.com"}, codec)
+
+			_, err := tUrl.MarshalJSON()
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("url 'http://example.com' must use one of the schemes [https, wss]"))
+		})
+
+		t.Run("It can encode a valid url", func(t *testing.T) {
+			tUrl := gotypedjson.NewTypedJson(gotypedjson.URL, &url.URL{Scheme: "wss", Host: "example.com"}, codec)
+
+			data, err := tUrl.MarshalJSON()
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(data)).To(Equal(`{"Type":"_url","Value":"wss://example.com"}`))
+		})
+	})
+
+	t.Run("Decoding", func(t *testing.T) {
+		t.Run("It returns an error if the url can not be parsed", func(t *testing.T) {
+			tUrl := gotypedjson.NewTypedJsonDecoder(codec)
+
+			err := json.Unmarshal([]byte(`{"Type":"_url","Value":"https://[::1"}`), tUrl)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal(`failed to convert 'https://[::1' to a url: parse "https://[::1": missing ']' in host`))
+		})
+
+		t.Run("It returns an error if the url is not absolute", func(t *testing.T) {
+			tUrl := gotypedjson.NewTypedJsonDecoder(codec)
+
+			err := json.Unmarshal([]byte(`{"Type":"_url","Value":"example.com/hooks"}`), tUrl)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("url 'example.com/hooks' must be absolute"))
+		})
+
+		t.Run("It returns an error if the scheme is not allowed", func(t *testing.T) {
+			tUrl := gotypedjson.NewTypedJsonDecoder(codec)
+
+			err := json.Unmarshal([]byte(`{"Type":"_url","Value":"ftp://example.com"}`), tUrl)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("url 'ftp://example.com' must use one of the schemes [https, wss]"))
+		})
+
+		t.Run("It can decode a valid url", func(t *testing.T) {
+			tUrl := gotypedjson.NewTypedJsonDecoder(codec)
+
+			err := json.Unmarshal([]byte(`{"Type":"_url","Value":"HTTPS://example.com/hooks"}`), tUrl)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tUrl.Type).To(Equal(gotypedjson.URL))
+			g.Expect(tUrl.Value.(*url.URL).String()).To(Equal("https://example.com/hooks"))
+		})
+	})
+}
@@ -0,0 +1,68 @@
+package gotypedjson
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// Uuid is a 16 byte universally unique identifier as defined by RFC 9562
+type Uuid [16]byte
+
+var (
+	// NilUuid is the special UUID with all bits set to zero
+	NilUuid = Uuid{}
+
+	// MaxUuid is the special UUID with all bits set to one
+	MaxUuid = Uuid{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+)
+
+//	PARAMETERS:
+//	* value - canonical hyphenated uuid in the form of `xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx`
+//
+//	RETURNS:
+//	* Uuid  - parsed uuid
+//	* error - error describing why the value is not a valid uuid
+//
+// Parses a uuid in its canonical form. Both upper and lower case hex digits are accepted, but the version must be
+// between 1 and 8 and the variant must be the RFC 9562 variant unless the value is the Nil or Max uuid.
+func ParseUuid(value string) (Uuid, error) {
+	var uuid Uuid
+
+	if len(value) != 36 {
+		return uuid, fmt.Errorf("uuid '%s' must be 36 characters", value)
+	}
+
+	if value[8] != '-' || value[13] != '-' || value[18] != '-' || value[23] != '-' {
+		return uuid, fmt.Errorf("uuid '%s' is not hyphenated as xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", value)
+	}
+
+	digits := value[0:8] + value[9:13] + value[14:18] + value[19:23] + value[24:36]
+	if _, err := hex.Decode(uuid[:], []byte(digits)); err != nil {
+		return Uuid{}, fmt.Errorf("uuid '%s' contains a non hex character", value)
+	}
+
+	if uuid == NilUuid || uuid == MaxUuid {
+		return uuid, nil
+	}
+
+	if version := uuid.Version(); version < 1 || version > 8 {
+		return Uuid{}, fmt.Errorf("uuid '%s' has an invalid version %d", value, version)
+	}
+
+	if uuid[8]&0xc0 != 0x80 {
+		return Uuid{}, fmt.Errorf("uuid '%s' is not the RFC 9562 variant", value)
+	}
+
+	return uuid, nil
+}
+
+// Version returns the version number stored in the uuid
+func (uuid Uuid) Version() int {
+	return int(uuid[6] >> 4)
+}
+
+// String returns the canonical lowercase hyphenated form of the uuid
+func (uuid Uuid) String() string {
+	encoded := hex.EncodeToString(uuid[:])
+	return encoded[0:8] + "-" + encoded[8:12] + "-" + encoded[12:16] + "-" + encoded[16:20] + "-" + encoded[20:32]
+}
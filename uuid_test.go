@@ -0,0 +1,58 @@
+package gotypedjson_test
+
+import (
+	"testing"
+
+	gotypedjson "github.com/DanLavine/go-typed-json"
+	. "github.com/onsi/gomega"
+)
+
+func Test_ParseUuid(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("It accepts upper and lower case values", func(t *testing.T) {
+		lower, err := gotypedjson.ParseUuid("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		upper, err := gotypedjson.ParseUuid("6BA7B810-9DAD-11D1-80B4-00C04FD430C8")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(lower).To(Equal(upper))
+		g.Expect(lower.Version()).To(Equal(1))
+		g.Expect(upper.String()).To(Equal("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+	})
+
+	t.Run("It accepts the nil and max uuids", func(t *testing.T) {
+		nilUuid, err := gotypedjson.ParseUuid("00000000-0000-0000-0000-000000000000")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(nilUuid).To(Equal(gotypedjson.NilUuid))
+
+		maxUuid, err := gotypedjson.ParseUuid("FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(maxUuid).To(Equal(gotypedjson.MaxUuid))
+	})
+
+	t.Run("It returns an error if the value is not hyphenated", func(t *testing.T) {
+		_, err := gotypedjson.ParseUuid("6ba7b8109dad11d180b400c04fd430c8abcd")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("uuid '6ba7b8109dad11d180b400c04fd430c8abcd' is not hyphenated as xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"))
+	})
+
+	t.Run("It returns an error if the value is not hex", func(t *testing.T) {
+		_, err := gotypedjson.ParseUuid("6ba7b810-9dad-11d1-80b4-00c04fd430cz")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("uuid '6ba7b810-9dad-11d1-80b4-00c04fd430cz' contains a non hex character"))
+	})
+
+	t.Run("It returns an error if the version is invalid", func(t *testing.T) {
+		_, err := gotypedjson.ParseUuid("6ba7b810-9dad-f1d1-80b4-00c04fd430c8")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("uuid '6ba7b810-9dad-f1d1-80b4-00c04fd430c8' has an invalid version 15"))
+	})
+
+	t.Run("It returns an error if the variant is invalid", func(t *testing.T) {
+		_, err := gotypedjson.ParseUuid("6ba7b810-9dad-11d1-c0b4-00c04fd430c8")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(Equal("uuid '6ba7b810-9dad-11d1-c0b4-00c04fd430c8' is not the RFC 9562 variant"))
+	})
+}
@@ -0,0 +1,179 @@
+package gotypedjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// UNSUPPORTEDPOLICY defines how Wrap handles values that do not have a type
+type UNSUPPORTEDPOLICY int
+
+const (
+	// UNSUPPORTED_REJECT returns an error for any value that does not have a type. This is the default policy
+	UNSUPPORTED_REJECT UNSUPPORTEDPOLICY = iota
+
+	// UNSUPPORTED_SKIP drops any map key or slice element that does not have a type. An unsupported value that is
+	// not inside of a map or slice still returns an error
+	UNSUPPORTED_SKIP
+
+	// UNSUPPORTED_JSON wraps any value that does not have a type as the JSON type, using encoding/json to encode it
+	UNSUPPORTED_JSON
+)
+
+// WrapOptions are the rules used by WrapWithOptions when converting a value
+type WrapOptions struct {
+	// Unsupported is the policy for values that do not have a type
+	Unsupported UNSUPPORTEDPOLICY
+
+	// CustomCodec is used to infer custom types and is set on every wrapped TypedJson
+	CustomCodec CustomCodec
+}
+
+//	PARAMETERS:
+//	* value - Value to recursively convert into a TypedJson
+//
+//	RETURNS:
+//	* *TypedJson - typed value for the value, or nil for a nil value
+//	* error      - error if any of the values do not have a type
+//
+// Wrap is WrapWithOptions using the default options, where any value that does not have a type returns an error.
+func Wrap(value any) (*TypedJson, error) {
+	return WrapWithOptions(value, WrapOptions{})
+}
+
+//	PARAMETERS:
+//	* value   - Value to recursively convert into a TypedJson
+//	* options - rules for custom and unsupported types
+//
+//	RETURNS:
+//	* *TypedJson - typed value for the value, or nil for a nil value
+//	* error      - error if any of the values could not be wrapped
+//
+// WrapWithOptions recursively converts a generic tree, such as the result of decoding json into a map[string]any,
+// into a TypedJson that preserves the Go type of every value. Each value is wrapped with the type inferred the same
+// way as NewInferredTypedJson. Maps with string keys and slices that do not have a type, like map[string]any and
+// []any, are wrapped as the OBJECT and ARRAY types with each of their values wrapped in turn. Go arrays, like [2]int,
+// do not have a type and follow the Unsupported policy. An untyped nil is wrapped as a nil TypedJson, which is
+// encoded as null.
+func WrapWithOptions(value any, options WrapOptions) (*TypedJson, error) {
+	typedJson, ok, err := wrap(value, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("failed to infer the type of %T", value)
+	}
+
+	return typedJson, nil
+}
+
+// wrap converts a single value into a TypedJson. The boolean is false when the value should be skipped.
+func wrap(value any, options WrapOptions) (*TypedJson, bool, error) {
+	if value == nil {
+		return nil, true, nil
+	}
+
+	if typedJson, ok := value.(*TypedJson); ok {
+		return typedJson, true, nil
+	}
+
+	jsonType, inferErr := inferJsonType(reflect.TypeOf(value), options.CustomCodec)
+	if inferErr == nil {
+		return NewTypedJson(jsonType, value, options.CustomCodec), true, nil
+	}
+
+	values := reflect.ValueOf(value)
+	switch {
+	case values.Kind() == reflect.Map && values.Type().Key().Kind() == reflect.String:
+		if values.IsNil() {
+			return NewTypedJson(OBJECT, nil, options.CustomCodec), true, nil
+		}
+
+		objects := make(map[string]*TypedJson, values.Len())
+		for iter := values.MapRange(); iter.Next(); {
+			typedJson, ok, err := wrap(iter.Value().Interface(), options)
+			if err != nil {
+				return nil, false, fmt.Errorf("failed to wrap key '%s': %w", iter.Key().String(), err)
+			}
+
+			if ok {
+				objects[iter.Key().String()] = typedJson
+			}
+		}
+
+		return NewTypedJson(OBJECT, objects, options.CustomCodec), true, nil
+	case values.Kind() == reflect.Slice:
+		if values.IsNil() {
+			return NewTypedJson(ARRAY, nil, options.CustomCodec), true, nil
+		}
+
+		arrays := make([]*TypedJson, 0, values.Len())
+		for index := range values.Len() {
+			typedJson, ok, err := wrap(values.Index(index).Interface(), options)
+			if err != nil {
+				return nil, false, fmt.Errorf("failed to wrap index %d: %w", index, err)
+			}
+
+			if ok {
+				arrays = append(arrays, typedJson)
+			}
+		}
+
+		return NewTypedJson(ARRAY, arrays, options.CustomCodec), true, nil
+	}
+
+	switch options.Unsupported {
+	case UNSUPPORTED_SKIP:
+		return nil, false, nil
+	case UNSUPPORTED_JSON:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to wrap %T as json: %w", value, err)
+		}
+
+		return NewTypedJson(JSON, json.RawMessage(data), options.CustomCodec), true, nil
+	default:
+		return nil, false, inferErr
+	}
+}
+
+//	RETURNS:
+//	* any - plain Go value of the TypedJson
+//
+// Unwrap recursively converts a TypedJson back into plain Go values. The OBJECT and ARRAY types are returned as a
+// map[string]any and []any with each of their values unwrapped in turn. All other types return the Value with its
+// exact Go type, such as an int8 for the INT8 type. A nil TypedJson returns nil.
+func (typedJson *TypedJson) Unwrap() any {
+	if typedJson == nil {
+		return nil
+	}
+
+	switch values := typedJson.Value.(type) {
+	case map[string]*TypedJson:
+		if typedJson.Type != OBJECT {
+			return values
+		}
+
+		objects := make(map[string]any, len(values))
+		for key, value := range values {
+			objects[key] = value.Unwrap()
+		}
+
+		return objects
+	case []*TypedJson:
+		if typedJson.Type != ARRAY {
+			return values
+		}
+
+		arrays := make([]any, 0, len(values))
+		for _, value := range values {
+			arrays = append(arrays, value.Unwrap())
+		}
+
+		return arrays
+	default:
+		return typedJson.Value
+	}
+}
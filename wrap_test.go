@@ -0,0 +1,120 @@
+package gotypedjson_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	gotypedjson "github.com/DanLavine/go-typed-json"
+	. "github.com/onsi/gomega"
+)
+
+func Test_Wrap(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	t.Run("It wraps an untyped nil as a nil TypedJson", func(t *testing.T) {
+		tJson, err := gotypedjson.Wrap(nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tJson).To(BeNil())
+		g.Expect(tJson.Unwrap()).To(BeNil())
+	})
+
+	t.Run("It wraps scalars with the inferred type", func(t *testing.T) {
+		tJson, err := gotypedjson.Wrap(int8(3))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tJson.Type).To(Equal(gotypedjson.INT8))
+		g.Expect(tJson.Unwrap()).To(Equal(int8(3)))
+	})
+
+	t.Run("It recursively wraps maps and slices", func(t *testing.T) {
+		tJson, err := gotypedjson.Wrap(map[string]any{
+			"count": uint16(2),
+			"tags":  []string{"a"},
+			"items": []any{1.5, "b", nil, map[string]any{"ok": true}},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tJson.Type).To(Equal(gotypedjson.OBJECT))
+
+		objects := tJson.Value.(map[string]*gotypedjson.TypedJson)
+		g.Expect(objects["count"].Type).To(Equal(gotypedjson.UINT16))
+		g.Expect(objects["tags"].Type).To(Equal(gotypedjson.STRING_SLICE))
+		g.Expect(objects["items"].Type).To(Equal(gotypedjson.ARRAY))
+
+		arrays := objects["items"].Value.([]*gotypedjson.TypedJson)
+		g.Expect(arrays[0].Type).To(Equal(gotypedjson.FLOAT64))
+		g.Expect(arrays[2]).To(BeNil())
+		g.Expect(arrays[3].Type).To(Equal(gotypedjson.OBJECT))
+	})
+
+	t.Run("Unsupported types", func(t *testing.T) {
+		value := map[string]any{"ok": true, "bad": []any{struct{ ID int }{ID: 1}}}
+
+		t.Run("It returns an error by default", func(t *testing.T) {
+			_, err := gotypedjson.Wrap(value)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to wrap key 'bad': failed to wrap index 0: failed to infer the type of struct { ID int }"))
+		})
+
+		t.Run("It can skip unsupported values", func(t *testing.T) {
+			tJson, err := gotypedjson.WrapWithOptions(value, gotypedjson.WrapOptions{Unsupported: gotypedjson.UNSUPPORTED_SKIP})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tJson.Unwrap()).To(Equal(map[string]any{"ok": true, "bad": []any{}}))
+
+			_, err = gotypedjson.WrapWithOptions(struct{}{}, gotypedjson.WrapOptions{Unsupported: gotypedjson.UNSUPPORTED_SKIP})
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to infer the type of struct {}"))
+		})
+
+		t.Run("It can wrap unsupported values as json", func(t *testing.T) {
+			tJson, err := gotypedjson.WrapWithOptions(value, gotypedjson.WrapOptions{Unsupported: gotypedjson.UNSUPPORTED_JSON})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tJson.Unwrap()).To(Equal(map[string]any{"ok": true, "bad": []any{json.RawMessage(`{"ID":1}`)}}))
+		})
+
+		t.Run("It applies the policy to arrays", func(t *testing.T) {
+			_, err := gotypedjson.Wrap([2]int{1, 2})
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(Equal("failed to infer the type of [2]int"))
+
+			tJson, err := gotypedjson.WrapWithOptions([]any{[2]int{1, 2}}, gotypedjson.WrapOptions{Unsupported: gotypedjson.UNSUPPORTED_SKIP})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tJson.Unwrap()).To(Equal([]any{}))
+
+			tJson, err = gotypedjson.WrapWithOptions([2]int{1, 2}, gotypedjson.WrapOptions{Unsupported: gotypedjson.UNSUPPORTED_JSON})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(tJson.Type).To(Equal(gotypedjson.JSON))
+			g.Expect(tJson.Unwrap()).To(Equal(json.RawMessage(`[1,2]`)))
+		})
+	})
+
+	t.Run("It infers types from the custom codec", func(t *testing.T) {
+		codec := gotypedjson.CustomCodec{"status": gotypedjson.NewEnumCodec(testStatusActive)}
+
+		tJson, err := gotypedjson.WrapWithOptions([]any{testStatusActive}, gotypedjson.WrapOptions{CustomCodec: codec})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		data, err := json.Marshal(tJson)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(data)).To(Equal(`{"Type":"_array","Value":[{"Type":"status","Value":"active"}]}`))
+	})
+
+	t.Run("It preserves the exact types after encoding and decoding", func(t *testing.T) {
+		value := map[string]any{
+			"count":    int32(-4),
+			"ratio":    float32(0.5),
+			"duration": time.Second,
+			"nested":   map[string]any{"ids": []uint64{1, 2}, "none": nil},
+			"list":     []any{"a", int64(1)},
+		}
+
+		tJson, err := gotypedjson.Wrap(value)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		data, err := json.Marshal(tJson)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		decoded := gotypedjson.NewTypedJsonDecoder(nil)
+		g.Expect(json.Unmarshal(data, decoded)).ToNot(HaveOccurred())
+		g.Expect(decoded.Unwrap()).To(Equal(value))
+	})
+}